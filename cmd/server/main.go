@@ -0,0 +1,448 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/steuercarlsen/chessDungeonCrawler/internal/game"
+	"github.com/steuercarlsen/chessDungeonCrawler/internal/game_data"
+)
+
+// Only these file types are served from the web root, everything else (Go sources, .git etc.) is hidden
+var staticTypes = map[string]string{
+	".html": "text/html; charset=utf-8",
+	".js":   "text/javascript; charset=utf-8",
+	".css":  "text/css; charset=utf-8",
+	".wasm": "application/wasm",
+}
+
+// Mirrors the API table in cmd/wasm so the game can be driven over HTTP without a browser
+type APIFunction struct {
+	Name string
+	Func func(*Server, APIRequest) (any, error)
+}
+
+var API = []APIFunction{
+	{Name: "selectEncounter", Func: SelectEncounter},
+	{Name: "selectHero", Func: SelectHero},
+	{Name: "initiateCombat", Func: InitiateCombat},
+	{Name: "getSquare", Func: GetSquare},
+	{Name: "endTurn", Func: EndTurn},
+	{Name: "getStatusEffects", Func: GetStatusEffects},
+	{Name: "getAbilities", Func: GetAbilities},
+	{Name: "getAffectedSquares", Func: GetAffectedSquares},
+	{Name: "getBoardSize", Func: GetBoardSize},
+	{Name: "getMoveRange", Func: GetMoveRange},
+	{Name: "aiTurn", Func: AITurn},
+}
+
+// Budget of the search the AI runs for each of its actions
+const (
+	aiTimeLimit     = 2000 // Milliseconds
+	aiIterationGoal = 1000
+	aiMaxDepth      = 40
+)
+
+type APIRequest struct {
+	EncounterID string   `json:"encounterID"`
+	HeroID      string   `json:"heroID"`
+	Items       []string `json:"items"`
+	Index       int      `json:"index"`
+	Ability     int      `json:"ability"`
+	Target      int      `json:"target"`
+}
+
+type APIResponse struct {
+	Result any    `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Holds one game session. Every API call is serialized through mu
+type Server struct {
+	mu                sync.Mutex
+	root              string
+	mux               *http.ServeMux
+	GameState         *game.State
+	SelectedPiece     *game.Piece
+	SelectedEncounter game_data.Encounter
+	SelectedHeroes    []game_data.Hero
+
+	// The AI's search, kept for the whole encounter, and the player's actions since the AI last played so the
+	// search can follow them
+	search        *game.MCTS
+	playerActions []game.Action
+	// Seed of the next combat, the clock unless a test needs a fixed one
+	newSeed func() int64
+}
+
+func NewServer(root string) *Server {
+	for ext, mimeType := range staticTypes {
+		mime.AddExtensionType(ext, mimeType)
+	}
+
+	s := &Server{
+		root:           root,
+		mux:            http.NewServeMux(),
+		GameState:      &game.State{GameState: game.SetupCombat},
+		SelectedHeroes: make([]game_data.Hero, 0, game_data.MaxPartySize),
+		newSeed:        func() int64 { return time.Now().UnixNano() },
+	}
+
+	for _, api := range API {
+		s.mux.HandleFunc("/api/"+api.Name, s.apiHandler(api))
+	}
+	s.mux.HandleFunc("/", s.serveStatic)
+
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) apiHandler(api APIFunction) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeJSON(w, http.StatusMethodNotAllowed, APIResponse{Error: "method not allowed"})
+			return
+		}
+
+		var request APIRequest
+		if r.ContentLength != 0 {
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				writeJSON(w, http.StatusBadRequest, APIResponse{Error: "invalid request body: " + err.Error()})
+				return
+			}
+		}
+
+		s.mu.Lock()
+		result, err := api.Func(s, request)
+		s.mu.Unlock()
+
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, APIResponse{Error: err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, APIResponse{Result: result})
+	}
+}
+
+func (s *Server) serveStatic(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := path.Clean("/" + r.URL.Path)
+	if name == "/" {
+		name = "/index.html"
+	}
+
+	mimeType, ok := staticTypes[path.Ext(name)]
+	if !ok || strings.Contains(name, "/.") {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", mimeType)
+	http.ServeFile(w, r, filepath.Join(s.root, filepath.FromSlash(name)))
+}
+
+func SelectEncounter(s *Server, request APIRequest) (any, error) {
+	encounter, exists := game_data.Encounters[request.EncounterID]
+	if !exists {
+		return nil, fmt.Errorf("unknown encounter %q", request.EncounterID)
+	}
+
+	state, err := encounter.NewCombatState()
+	if err != nil {
+		return nil, err
+	}
+
+	s.SelectedEncounter = encounter
+	s.SelectedPiece = nil
+	s.GameState = &state
+	s.search, s.playerActions = nil, nil
+	return "Encounter selected", nil
+}
+
+func SelectHero(s *Server, request APIRequest) (any, error) {
+	if len(s.SelectedHeroes) == game_data.MaxPartySize {
+		return "Party full", nil
+	}
+
+	hero, exists := game_data.Heroes[request.HeroID]
+	if !exists {
+		return nil, fmt.Errorf("unknown hero %q", request.HeroID)
+	}
+
+	for _, itemID := range request.Items {
+		item, exists := game_data.Items[itemID]
+		if !exists {
+			return nil, fmt.Errorf("unknown item %q", itemID)
+		}
+		hero.Equip(item)
+	}
+
+	s.SelectedHeroes = append(s.SelectedHeroes, hero)
+	return "Hero selected", nil
+}
+
+// Deploys the selected heroes on the player area and starts combat. The seed is recorded in the state so
+// the combat can be replayed
+func InitiateCombat(s *Server, request APIRequest) (any, error) {
+	if len(s.GameState.Board.BoardArray) == 0 {
+		return nil, errors.New("no encounter selected")
+	}
+	if s.GameState.GameState != game.SetupCombat {
+		return "Combat already started", nil
+	}
+	if err := s.SelectedEncounter.DeployHeroes(s.GameState, s.SelectedHeroes); err != nil {
+		return nil, err
+	}
+
+	s.GameState.StartCombat(s.newSeed())
+	s.search, s.playerActions = nil, nil
+	return "Combat initiated", nil
+}
+
+func GetSquare(s *Server, request APIRequest) (any, error) {
+	if request.Index < 0 || request.Index >= len(s.GameState.Board.BoardArray) {
+		return nil, errors.New("square index out of range")
+	}
+
+	piece := &s.GameState.Board.BoardArray[request.Index]
+
+	switch s.GameState.GameState {
+	case game.SetupCombat:
+		if piece.PieceType == game.PlayerAreaPiece {
+			return "PlayerArea", nil
+		}
+		return "You can only select PlayerArea", nil
+	case game.InCombat:
+		if piece.PieceType == game.EnemyPiece {
+			if s.SelectedPiece != nil {
+				return "Action chosen against Enemy", nil
+			}
+			return "You can't select the Enemy", nil
+		}
+		if piece.PieceType == game.PlayerPiece {
+			s.SelectedPiece = piece
+			return "PlayerPiece selected", nil
+		}
+	}
+	return "Invalid state", nil
+}
+
+func EndTurn(s *Server, request APIRequest) (any, error) {
+	if !s.GameState.WaitingForInput() || s.GameState.CurrentActor != game.PlayerActor {
+		return "Not your turn", nil
+	}
+	s.playerAction(game.EndTurnAction)
+	return "Turn ended", nil
+}
+
+// Plays an action for the player. Every player action goes through here so the AI's search can follow it
+func (s *Server) playerAction(action game.Action) {
+	s.GameState.ExecuteAction(action)
+	s.playerActions = append(s.playerActions, action)
+}
+
+var actionTypeNames = map[game.ActionType]string{
+	game.MoveType:    "move",
+	game.AbilityType: "ability",
+	game.EndTurnType: "endTurn",
+}
+
+type AIActionView struct {
+	Type          string `json:"type"`
+	Index         int    `json:"index"`
+	Target        int    `json:"target"`
+	Ability       string `json:"ability,omitempty"`
+	Iterations    uint64 `json:"iterations"`
+	CarriedVisits uint64 `json:"carriedVisits"` // Visits of the tree kept from the searches before this one
+}
+
+// Lets the AI play its whole turn, searching with the selected encounter's AI profile for every action.
+// Returns the actions played in order
+func AITurn(s *Server, request APIRequest) (any, error) {
+	if !s.GameState.WaitingForInput() || s.GameState.CurrentActor != game.AIActor {
+		return "Not the AI's turn", nil
+	}
+
+	// One search for the whole encounter, seeded by the combat so the AI plays the same in a replay. It's
+	// advanced past every action, the player's included, so each search starts from the subtree of the last
+	if s.search == nil {
+		search, err := s.SelectedEncounter.NewSearch(*s.GameState, aiTimeLimit, aiIterationGoal, aiMaxDepth)
+		if err != nil {
+			return nil, err
+		}
+		search.SetSeed(s.GameState.Seed)
+		s.search = search
+	} else if len(s.playerActions) > 0 {
+		s.search.Advance(*s.GameState, s.playerActions)
+	}
+	s.playerActions = nil
+	search := s.search
+
+	played := []AIActionView{}
+	for s.GameState.WaitingForInput() && s.GameState.CurrentActor == game.AIActor {
+		action := game.EndTurnAction
+		best, metadata := search.Search()
+		if best != nil {
+			action = *best
+		}
+		s.GameState.ExecuteAction(action)
+		search.Advance(*s.GameState, []game.Action{action})
+
+		view := AIActionView{
+			Type:          actionTypeNames[action.ActionType],
+			Index:         int(action.Index),
+			Target:        int(action.Target),
+			Iterations:    metadata.Iterations,
+			CarriedVisits: metadata.CarriedVisits,
+		}
+		if action.Ability != nil {
+			view.Ability = action.Ability.Name
+		}
+		played = append(played, view)
+	}
+	return played, nil
+}
+
+type StatusEffectView struct {
+	Name     string `json:"name"`
+	Duration uint8  `json:"duration"`
+	Stacks   uint8  `json:"stacks"`
+}
+
+func GetStatusEffects(s *Server, request APIRequest) (any, error) {
+	if request.Index < 0 || request.Index >= len(s.GameState.Board.BoardArray) {
+		return nil, errors.New("square index out of range")
+	}
+
+	piece := &s.GameState.Board.BoardArray[request.Index]
+
+	effects := make([]StatusEffectView, 0, len(piece.StatusEffects))
+	for _, effect := range piece.StatusEffects {
+		effects = append(effects, StatusEffectView{Name: effect.Name, Duration: effect.Duration, Stacks: effect.Stacks})
+	}
+	return effects, nil
+}
+
+type AbilityView struct {
+	Name         string  `json:"name"`
+	Range        uint8   `json:"range"`
+	Cost         float64 `json:"cost"`
+	Cooldown     uint8   `json:"cooldown"`
+	CooldownLeft uint8   `json:"cooldownLeft"`
+	Ready        bool    `json:"ready"`
+}
+
+// Returns the state of every ability of the piece for the ability bar
+func GetAbilities(s *Server, request APIRequest) (any, error) {
+	if request.Index < 0 || request.Index >= len(s.GameState.Board.BoardArray) {
+		return nil, errors.New("square index out of range")
+	}
+
+	piece := &s.GameState.Board.BoardArray[request.Index]
+
+	abilities := make([]AbilityView, 0, len(piece.Abilities))
+	for i, ability := range piece.Abilities {
+		abilities = append(abilities, AbilityView{
+			Name:         ability.Name,
+			Range:        ability.Range,
+			Cost:         ability.Cost,
+			Cooldown:     ability.Cooldown,
+			CooldownLeft: piece.CooldownLeft(i),
+			Ready:        piece.CanAfford(i),
+		})
+	}
+	return abilities, nil
+}
+
+// Returns the squares the ability in slot Ability of the piece on Index would hit when aimed at Target
+func GetAffectedSquares(s *Server, request APIRequest) (any, error) {
+	boardSize := len(s.GameState.Board.BoardArray)
+	if request.Index < 0 || request.Index >= boardSize || request.Target < 0 || request.Target >= boardSize {
+		return nil, errors.New("square index out of range")
+	}
+
+	caster := &s.GameState.Board.BoardArray[request.Index]
+	if request.Ability < 0 || request.Ability >= len(caster.Abilities) {
+		return nil, errors.New("ability slot out of range")
+	}
+
+	squares := caster.Abilities[request.Ability].Area.AffectedSquares(&s.GameState.Board, caster.Index, uint16(request.Target))
+
+	// Widened so the squares encode as a JSON array and not as bytes
+	affected := make([]int, 0, len(squares))
+	for _, square := range squares {
+		affected = append(affected, int(square))
+	}
+	return affected, nil
+}
+
+type BoardSizeView struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// Returns the size of the selected encounter's board, squares are indexed row by row
+func GetBoardSize(s *Server, request APIRequest) (any, error) {
+	if len(s.GameState.Board.BoardArray) == 0 {
+		return nil, errors.New("no encounter selected")
+	}
+	return BoardSizeView{Width: s.GameState.Board.Width(), Height: s.GameState.Board.Height()}, nil
+}
+
+// Returns every square the piece on Index can move to, for the range overlay
+func GetMoveRange(s *Server, request APIRequest) (any, error) {
+	if request.Index < 0 || request.Index >= len(s.GameState.Board.BoardArray) {
+		return nil, errors.New("square index out of range")
+	}
+
+	piece := &s.GameState.Board.BoardArray[request.Index]
+	squares := s.GameState.Board.CalculateRange(piece.Index, piece.MoveRange)
+
+	reachable := make([]int, 0, len(squares))
+	for _, square := range squares {
+		reachable = append(reachable, int(square))
+	}
+	return reachable, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, response APIResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Printf("failed to write response: %v", err)
+	}
+}
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	root := flag.String("root", ".", "directory containing index.html and web/static")
+	encounters := flag.String("encounters", "", "directory with extra encounter .json files")
+	flag.Parse()
+
+	if *encounters != "" {
+		if err := game_data.LoadEncounters(os.DirFS(*encounters), "."); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	log.Printf("Serving %s on %s", *root, *addr)
+	log.Fatal(http.ListenAndServe(*addr, NewServer(*root)))
+}
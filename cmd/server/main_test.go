@@ -0,0 +1,256 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/steuercarlsen/chessDungeonCrawler/internal/game"
+)
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	root := t.TempDir()
+	files := map[string]string{
+		"index.html":        "<html></html>",
+		"app.js":            "let a = 1;",
+		"style.css":         "body {}",
+		"web/static/x.wasm": "\x00asm",
+		"main.go":           "package main",
+		".secret.js":        "hidden",
+		".git/config.js":    "hidden",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return NewServer(root)
+}
+
+func TestServeStatic(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		path        string
+		status      int
+		contentType string
+	}{
+		{"/", http.StatusOK, "text/html; charset=utf-8"},
+		{"/app.js", http.StatusOK, "text/javascript; charset=utf-8"},
+		{"/style.css", http.StatusOK, "text/css; charset=utf-8"},
+		{"/web/static/x.wasm", http.StatusOK, "application/wasm"},
+		{"/main.go", http.StatusNotFound, ""},
+		{"/.secret.js", http.StatusNotFound, ""},
+		{"/.git/config.js", http.StatusNotFound, ""},
+	}
+
+	for _, test := range tests {
+		recorder := httptest.NewRecorder()
+		s.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, test.path, nil))
+		if recorder.Code != test.status {
+			t.Errorf("GET %s: status %d, want %d", test.path, recorder.Code, test.status)
+			continue
+		}
+		if test.contentType != "" && recorder.Header().Get("Content-Type") != test.contentType {
+			t.Errorf("GET %s: content type %q, want %q", test.path, recorder.Header().Get("Content-Type"), test.contentType)
+		}
+	}
+
+	recorder := httptest.NewRecorder()
+	s.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/index.html", nil))
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST /index.html: status %d, want %d", recorder.Code, http.StatusMethodNotAllowed)
+	}
+}
+
+func callAPI(t *testing.T, s *Server, name, body string) (int, APIResponse) {
+	t.Helper()
+	recorder := httptest.NewRecorder()
+	s.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/api/"+name, strings.NewReader(body)))
+
+	var response APIResponse
+	if err := json.NewDecoder(recorder.Body).Decode(&response); err != nil {
+		t.Fatalf("%s: invalid response: %v", name, err)
+	}
+	return recorder.Code, response
+}
+
+func TestAPIRoutes(t *testing.T) {
+	s := newTestServer(t)
+
+	// In order, as later calls need the encounter selected by the first
+	tests := []struct {
+		name   string
+		body   string
+		result string
+	}{
+		{"selectEncounter", `{"encounterID": "TestEncounter"}`, `"Encounter selected"`},
+		{"selectHero", `{"heroID": "Knight", "items": ["IronSword"]}`, `"Hero selected"`},
+		{"initiateCombat", ``, `"Combat initiated"`},
+		{"getSquare", `{"index": 2}`, `"PlayerPiece selected"`},
+		{"aiTurn", ``, `"Not the AI's turn"`},
+		{"getStatusEffects", `{"index": 0}`, `[]`},
+		{"getAbilities", `{"index": 0}`, `[{"name":"Strike","range":1,"cost":0,"cooldown":0,"cooldownLeft":0,"ready":true}]`},
+		{"getAffectedSquares", `{"index": 0, "ability": 0, "target": 8}`, `[8]`},
+		{"getBoardSize", ``, `{"width":8,"height":8}`},
+		{"getMoveRange", `{"index": 0}`, `[8,16,9]`},
+		{"endTurn", ``, `"Turn ended"`},
+	}
+
+	tested := map[string]bool{}
+	for _, test := range tests {
+		tested[test.name] = true
+		status, response := callAPI(t, s, test.name, test.body)
+		if status != http.StatusOK {
+			t.Errorf("%s: status %d (%s)", test.name, status, response.Error)
+			continue
+		}
+		// Round trip the expected result so key order doesn't matter
+		var want any
+		if err := json.Unmarshal([]byte(test.result), &want); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(response.Result, want) {
+			result, _ := json.Marshal(response.Result)
+			t.Errorf("%s: result %s, want %s", test.name, result, test.result)
+		}
+	}
+
+	for _, api := range API {
+		if !tested[api.Name] {
+			t.Errorf("route /api/%s is not tested", api.Name)
+		}
+	}
+}
+
+// Selects TestEncounter with a knight and starts combat, the knight is deployed on square 2
+func startTestCombat(t *testing.T, s *Server) {
+	t.Helper()
+	for _, call := range [][2]string{
+		{"selectEncounter", `{"encounterID": "TestEncounter"}`},
+		{"selectHero", `{"heroID": "Knight"}`},
+		{"initiateCombat", ``},
+	} {
+		if status, response := callAPI(t, s, call[0], call[1]); status != http.StatusOK {
+			t.Fatalf("%s: %s", call[0], response.Error)
+		}
+	}
+}
+
+func TestInitiateCombat(t *testing.T) {
+	s := newTestServer(t)
+	callAPI(t, s, "selectEncounter", `{"encounterID": "TestEncounter"}`)
+	if status, _ := callAPI(t, s, "initiateCombat", ``); status != http.StatusBadRequest {
+		t.Errorf("initiateCombat without heroes: status %d, want %d", status, http.StatusBadRequest)
+	}
+
+	startTestCombat(t, s)
+
+	state := s.GameState
+	if state.GameState != game.InCombat || !state.WaitingForInput() || state.CurrentActor != game.PlayerActor {
+		t.Fatalf("combat did not start with the player's turn: %+v", state)
+	}
+	if knight := state.Board.BoardArray[2]; knight.Name != "Knight" || knight.PieceType != game.PlayerPiece {
+		t.Errorf("square 2 holds %s instead of the knight", knight.Name)
+	}
+	if state.Board.MoveCost(10) == 1 {
+		t.Error("deploying the heroes removed the mud")
+	}
+
+	// The recorded seed replays the combat
+	setup, err := s.SelectedEncounter.NewCombatState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SelectedEncounter.DeployHeroes(&setup, s.SelectedHeroes); err != nil {
+		t.Fatal(err)
+	}
+	if replayed := game.Replay(setup, state.Seed, nil); !reflect.DeepEqual(replayed, *state) {
+		t.Errorf("replaying seed %d gave a different state", state.Seed)
+	}
+
+	if _, response := callAPI(t, s, "initiateCombat", ``); response.Result != "Combat already started" {
+		t.Errorf("second initiateCombat: %v", response.Result)
+	}
+}
+
+// Two rounds of the player ending the turn and the AI playing. The search is kept for the whole encounter, so
+// only the very first action starts from an empty tree
+func TestAITurn(t *testing.T) {
+	s := newTestServer(t)
+	s.newSeed = func() int64 { return 1 }
+	startTestCombat(t, s)
+
+	for round := range 2 {
+		if _, response := callAPI(t, s, "endTurn", ``); response.Result != "Turn ended" {
+			t.Fatalf("round %d endTurn: %v", round, response.Result)
+		}
+
+		status, response := callAPI(t, s, "aiTurn", ``)
+		if status != http.StatusOK {
+			t.Fatal(response.Error)
+		}
+		played, ok := response.Result.([]any)
+		if !ok || len(played) == 0 {
+			t.Fatalf("round %d: AI played %v", round, response.Result)
+		}
+		for i, entry := range played {
+			action := entry.(map[string]any)
+			if action["iterations"].(float64) == 0 {
+				t.Errorf("round %d: %v was played without searching", round, action)
+			}
+			carried, ok := action["carriedVisits"].(float64)
+			if !ok || (round == 0 && i == 0) != (carried == 0) {
+				t.Errorf("round %d action %d carried %v visits", round, i, action["carriedVisits"])
+			}
+		}
+		if s.GameState.WaitingForInput() && s.GameState.CurrentActor == game.AIActor {
+			t.Fatalf("round %d: the AI did not finish its turn", round)
+		}
+
+		if _, response := callAPI(t, s, "aiTurn", ``); response.Result != "Not the AI's turn" {
+			t.Errorf("round %d: second AI turn in a row: %v", round, response.Result)
+		}
+	}
+}
+
+func TestAPIErrors(t *testing.T) {
+	s := newTestServer(t)
+
+	recorder := httptest.NewRecorder()
+	s.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/getBoardSize", nil))
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET on the API: status %d, want %d", recorder.Code, http.StatusMethodNotAllowed)
+	}
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"selectEncounter", `{"encounterID": "Unknown"}`},
+		{"selectEncounter", `{not json`},
+		{"selectHero", `{"heroID": "Unknown"}`},
+		{"selectHero", `{"heroID": "Knight", "items": ["Unknown"]}`},
+		{"getBoardSize", ``},
+		{"initiateCombat", ``},
+		{"getSquare", `{"index": -1}`},
+		{"getMoveRange", `{"index": 64}`},
+	}
+
+	for _, test := range tests {
+		status, response := callAPI(t, s, test.name, test.body)
+		if status != http.StatusBadRequest || response.Error == "" {
+			t.Errorf("%s %s: status %d error %q, want a bad request", test.name, test.body, status, response.Error)
+		}
+	}
+}
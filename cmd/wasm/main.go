@@ -4,6 +4,7 @@ package main
 
 import (
 	"syscall/js"
+	"time"
 
 	"github.com/steuercarlsen/chessDungeonCrawler/internal/game"
 	"github.com/steuercarlsen/chessDungeonCrawler/internal/game_data"
@@ -13,6 +14,11 @@ var (
 	GameState         *game.State
 	SelectedEncounter game_data.Encounter
 	SelectedHeroes    = make([]game_data.Hero, 0, game_data.MaxPartySize)
+
+	// The AI's search, kept for the whole encounter, and the player's actions since the AI last played so the
+	// search can follow them
+	AISearch      *game.MCTS
+	PlayerActions []game.Action
 )
 
 type JSFunction struct {
@@ -25,8 +31,22 @@ var API = []JSFunction{
 	{Name: "selectHero", Func: SelectHero},
 	{Name: "initiateCombat", Func: InitiateCombat},
 	{Name: "getSquare", Func: GetSquare},
+	{Name: "endTurn", Func: EndTurn},
+	{Name: "getStatusEffects", Func: GetStatusEffects},
+	{Name: "getAbilities", Func: GetAbilities},
+	{Name: "getAffectedSquares", Func: GetAffectedSquares},
+	{Name: "getBoardSize", Func: GetBoardSize},
+	{Name: "getMoveRange", Func: GetMoveRange},
+	{Name: "aiTurn", Func: AITurn},
 }
 
+// Budget of the search the AI runs for each of its actions
+const (
+	aiTimeLimit     = 2000 // Milliseconds
+	aiIterationGoal = 1000
+	aiMaxDepth      = 40
+)
+
 func RegisterAPI() {
 	for _, api := range API {
 		js.Global().Set(api.Name, js.FuncOf(api.Func))
@@ -35,7 +55,19 @@ func RegisterAPI() {
 
 func SelectEncounter(this js.Value, args []js.Value) any {
 	encounterID := args[0].String()
-	SelectedEncounter = game_data.Encounters[encounterID]
+	encounter, exists := game_data.Encounters[encounterID]
+	if !exists {
+		return "Unknown encounter"
+	}
+
+	state, err := encounter.NewCombatState()
+	if err != nil {
+		return err.Error()
+	}
+
+	SelectedEncounter = encounter
+	game.ActiveGame = state
+	AISearch, PlayerActions = nil, nil
 	return "Encounter selected"
 }
 
@@ -50,18 +82,52 @@ func SelectHero(this js.Value, args []js.Value) any {
 	itemsArray := args[1]
 	for i := 0; i < itemsArray.Length(); i++ {
 		itemID := itemsArray.Index(i).String()
-		hero.Equipment = append(hero.Equipment, game_data.Items[itemID])
+		item, exists := game_data.Items[itemID]
+		if !exists {
+			return "Unknown item"
+		}
+		hero.Equip(item)
 	}
 
 	SelectedHeroes = append(SelectedHeroes, hero)
 	return "Hero selected"
 }
 
+// Deploys the selected heroes on the player area and starts combat. The seed is recorded in the state so
+// the combat can be replayed
 func InitiateCombat(this js.Value, args []js.Value) any {
+	if len(game.ActiveGame.Board.BoardArray) == 0 {
+		return "No encounter selected"
+	}
+	if game.ActiveGame.GameState != game.SetupCombat {
+		return "Combat already started"
+	}
+	if err := SelectedEncounter.DeployHeroes(&game.ActiveGame, SelectedHeroes); err != nil {
+		return err.Error()
+	}
+
+	game.ActiveGame.StartCombat(time.Now().UnixNano())
+	AISearch, PlayerActions = nil, nil
 	return "Combat initiated"
 }
 
+// Checks a square index from JS against the selected encounter's board, the board is empty until one is
+// selected. Returns the message to hand back instead if the square isn't on the board
+func checkSquare(index int) (string, bool) {
+	if len(game.ActiveGame.Board.BoardArray) == 0 {
+		return "No encounter selected", false
+	}
+	if index < 0 || index >= len(game.ActiveGame.Board.BoardArray) {
+		return "Square index out of range", false
+	}
+	return "", true
+}
+
 func GetSquare(this js.Value, args []js.Value) any {
+	if message, ok := checkSquare(args[0].Int()); !ok {
+		return message
+	}
+
 	piece := &game.ActiveGame.Board.BoardArray[args[0].Int()]
 	activeGameState := game.ActiveGame.GameState
 
@@ -86,6 +152,163 @@ func GetSquare(this js.Value, args []js.Value) any {
 	return "Invalid state"
 }
 
+func EndTurn(this js.Value, args []js.Value) any {
+	if !game.ActiveGame.WaitingForInput() || game.ActiveGame.CurrentActor != game.PlayerActor {
+		return "Not your turn"
+	}
+	playerAction(game.EndTurnAction)
+	return "Turn ended"
+}
+
+// Plays an action for the player. Every player action goes through here so the AI's search can follow it
+func playerAction(action game.Action) {
+	game.ActiveGame.ExecuteAction(action)
+	PlayerActions = append(PlayerActions, action)
+}
+
+var actionTypeNames = map[game.ActionType]string{
+	game.MoveType:    "move",
+	game.AbilityType: "ability",
+	game.EndTurnType: "endTurn",
+}
+
+// Lets the AI play its whole turn, searching with the selected encounter's AI profile for every action.
+// Returns the actions played in order
+func AITurn(this js.Value, args []js.Value) any {
+	if !game.ActiveGame.WaitingForInput() || game.ActiveGame.CurrentActor != game.AIActor {
+		return "Not the AI's turn"
+	}
+
+	// One search for the whole encounter, seeded by the combat so the AI plays the same in a replay. It's
+	// advanced past every action, the player's included, so each search starts from the subtree of the last
+	if AISearch == nil {
+		search, err := SelectedEncounter.NewSearch(game.ActiveGame, aiTimeLimit, aiIterationGoal, aiMaxDepth)
+		if err != nil {
+			return err.Error()
+		}
+		search.SetSeed(game.ActiveGame.Seed)
+		AISearch = search
+	} else if len(PlayerActions) > 0 {
+		AISearch.Advance(game.ActiveGame, PlayerActions)
+	}
+	PlayerActions = nil
+	search := AISearch
+
+	played := make([]any, 0, 8)
+	for game.ActiveGame.WaitingForInput() && game.ActiveGame.CurrentActor == game.AIActor {
+		action := game.EndTurnAction
+		best, metadata := search.Search()
+		if best != nil {
+			action = *best
+		}
+		game.ActiveGame.ExecuteAction(action)
+		search.Advance(game.ActiveGame, []game.Action{action})
+
+		view := map[string]any{
+			"type":          actionTypeNames[action.ActionType],
+			"index":         int(action.Index),
+			"target":        int(action.Target),
+			"iterations":    int(metadata.Iterations),
+			"carriedVisits": int(metadata.CarriedVisits),
+		}
+		if action.Ability != nil {
+			view["ability"] = action.Ability.Name
+		}
+		played = append(played, view)
+	}
+	return played
+}
+
+func GetStatusEffects(this js.Value, args []js.Value) any {
+	if message, ok := checkSquare(args[0].Int()); !ok {
+		return message
+	}
+
+	piece := &game.ActiveGame.Board.BoardArray[args[0].Int()]
+
+	effects := make([]any, 0, len(piece.StatusEffects))
+	for _, effect := range piece.StatusEffects {
+		effects = append(effects, map[string]any{
+			"name":     effect.Name,
+			"duration": int(effect.Duration),
+			"stacks":   int(effect.Stacks),
+		})
+	}
+	return effects
+}
+
+// Returns the state of every ability of the piece for the ability bar
+func GetAbilities(this js.Value, args []js.Value) any {
+	if message, ok := checkSquare(args[0].Int()); !ok {
+		return message
+	}
+
+	piece := &game.ActiveGame.Board.BoardArray[args[0].Int()]
+
+	abilities := make([]any, 0, len(piece.Abilities))
+	for i, ability := range piece.Abilities {
+		abilities = append(abilities, map[string]any{
+			"name":         ability.Name,
+			"range":        int(ability.Range),
+			"cost":         ability.Cost,
+			"cooldown":     int(ability.Cooldown),
+			"cooldownLeft": int(piece.CooldownLeft(i)),
+			"ready":        piece.CanAfford(i),
+		})
+	}
+	return abilities
+}
+
+// Args are the caster's square, the ability slot and the hovered square. Returns the squares the
+// ability would hit for the hover preview
+func GetAffectedSquares(this js.Value, args []js.Value) any {
+	for _, arg := range []js.Value{args[0], args[2]} {
+		if message, ok := checkSquare(arg.Int()); !ok {
+			return message
+		}
+	}
+
+	caster := &game.ActiveGame.Board.BoardArray[args[0].Int()]
+	slot := args[1].Int()
+	if slot < 0 || slot >= len(caster.Abilities) {
+		return []any{}
+	}
+
+	squares := caster.Abilities[slot].Area.AffectedSquares(&game.ActiveGame.Board, caster.Index, uint16(args[2].Int()))
+	affected := make([]any, 0, len(squares))
+	for _, square := range squares {
+		affected = append(affected, int(square))
+	}
+	return affected
+}
+
+// Returns the width and height of the selected encounter's board, squares are indexed row by row
+func GetBoardSize(this js.Value, args []js.Value) any {
+	if len(game.ActiveGame.Board.BoardArray) == 0 {
+		return "No encounter selected"
+	}
+	return map[string]any{
+		"width":  game.ActiveGame.Board.Width(),
+		"height": game.ActiveGame.Board.Height(),
+	}
+}
+
+// Returns every square the piece on the square in args[0] can move to, for the range overlay
+func GetMoveRange(this js.Value, args []js.Value) any {
+	if message, ok := checkSquare(args[0].Int()); !ok {
+		return message
+	}
+
+	piece := &game.ActiveGame.Board.BoardArray[args[0].Int()]
+
+	squares := game.ActiveGame.Board.CalculateRange(piece.Index, piece.MoveRange)
+	reachable := make([]any, 0, len(squares))
+	for _, square := range squares {
+		reachable = append(reachable, int(square))
+	}
+	return reachable
+}
+
 func main() {
 	RegisterAPI()
 
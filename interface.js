@@ -41,12 +41,19 @@ class VisualSquare extends VisualElement {
     }
 
     click() {
-        console.log(getSquare(this.position));
+        const result = getSquare(this.position);
+        console.log(result);
+
+        VisualBoard.hideMoveRange();
+        if (result === 'PlayerPiece selected') {
+            VisualBoard.showMoveRange(this.position);
+        }
     }
 }
 
 const VisualBoard = {
     contents: {},
+    moveRange: [],
 
     init(size) {
         for (let i = 0; i < size; i++) {
@@ -95,6 +102,25 @@ const VisualBoard = {
         this.contents['Square' + index].addClass('Range');
         this.contents['Square' + index].updateValue("🦶");
         return;
+    },
+
+    // Marks every square the piece on index can reach, difficult terrain included
+    showMoveRange(index) {
+        const range = getMoveRange(index);
+        if (!Array.isArray(range)) {
+            return console.log(range);
+        }
+        this.moveRange = range;
+        this.moveRange.forEach((square) => {
+            this.contents['Square' + square].addClass('Range');
+        });
+    },
+
+    hideMoveRange() {
+        this.moveRange.forEach((square) => {
+            this.contents['Square' + square].removeClass('Range');
+        });
+        this.moveRange = [];
     }
 };
 
@@ -177,8 +203,13 @@ const CombatButtons = {
     endTurn: new VisualElement('endTurnButton'),
 };
 
+// The Go side decides when combat starts and whose turn it is, the JS combat only follows it
 CombatButtons.startCombat.addEventListener('click', () => {
     if (!CurrentCombat.started) {
+        const result = initiateCombat();
+        if (result !== 'Combat initiated') {
+            return console.log(result);
+        }
         return CurrentCombat.start();
     }
     return console.log('Combat already started');
@@ -186,10 +217,12 @@ CombatButtons.startCombat.addEventListener('click', () => {
 
 CombatButtons.endTurn.addEventListener('click', () => {
     if (CurrentCombat.started) {
-        if (CurrentCombat.currentPlayer === 'player') {
-            return CurrentCombat.advanceTurn();
+        const result = endTurn();
+        if (result !== 'Turn ended') {
+            return console.log(result);
         }
-        return console.log('Not your turn');
+        CurrentCombat.advanceTurn();
+        return playAITurn();
     }
     return console.log('Combat not started');
 });
@@ -252,6 +285,10 @@ const combatLog = {
         if(type == "ability"){
             this.abilityEntry(payLoad);
         }
+
+        if(type == "ai"){
+            this.aiEntry(payLoad);
+        }
         
         if (this.log.length > this.maxEntries) {
             this.removeOldestEntry();
@@ -268,6 +305,16 @@ const combatLog = {
         this.shownLog.unshift(`Turn ${CurrentCombat.turn}: ${payLoad.piece} moved to square ${payLoad.index}`);
     },
 
+    aiEntry(payLoad) {
+        let done = 'ended its turn';
+        if (payLoad.type === 'ability') {
+            done = `used ${payLoad.ability} on square ${payLoad.target}`;
+        } else if (payLoad.type === 'move') {
+            done = `moved to square ${payLoad.target}`;
+        }
+        this.shownLog.unshift(`Turn ${CurrentCombat.turn}: AI on square ${payLoad.index} ${done} after ${payLoad.iterations} iterations, ${payLoad.carriedVisits} visits carried over`);
+    },
+
     removeOldestEntry() {
         this.log.pop();
         this.shownLog.pop();
@@ -281,4 +328,13 @@ const combatLog = {
         VisualCombatLog.update(this.shownLog);
     }
 
-}
\ No newline at end of file
+}
+
+// Lets the AI play its turn through the Go API and logs every action it took
+function playAITurn() {
+    const played = aiTurn();
+    if (!Array.isArray(played)) {
+        return console.log(played);
+    }
+    played.forEach((action) => combatLog.addEntry('ai', action));
+}
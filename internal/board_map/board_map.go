@@ -1,4297 +1,160 @@
 package board_map
 
-// Hardcoded squares on the LOS between [index][target]. 255 represents self and 254 a direct neighbor.
-var LOSLineMap = [64][64][]uint8{
-	{
-		{255},
-		{254},
-		{1},
-		{1, 2},
-		{1, 2, 3},
-		{1, 2, 3, 4},
-		{1, 2, 3, 4, 5},
-		{1, 2, 3, 4, 5, 6},
-		{254},
-		{254},
-		{1},
-		{1, 10},
-		{1, 2, 11},
-		{1, 2, 11, 12},
-		{1, 2, 3, 12, 13},
-		{1, 2, 3, 12, 13, 14},
-		{8},
-		{8},
-		{9},
-		{9, 10},
-		{1, 10, 11},
-		{1, 10, 11, 20},
-		{1, 10, 11, 12, 21},
-		{1, 10, 11, 12, 13, 22},
-		{8, 16},
-		{8, 17},
-		{9, 17},
-		{9, 18},
-		{9, 10, 19},
-		{9, 10, 19, 20},
-		{1, 10, 11, 20, 21},
-		{1, 10, 11, 20, 21, 30},
-		{8, 16, 24},
-		{8, 16, 25},
-		{8, 17, 25},
-		{9, 17, 26},
-		{9, 18, 27},
-		{9, 18, 19, 28},
-		{9, 10, 19, 28, 29},
-		{9, 10, 19, 20, 29, 30},
-		{8, 16, 24, 32},
-		{8, 16, 25, 33},
-		{8, 17, 25, 34},
-		{9, 17, 26, 34},
-		{9, 18, 26, 35},
-		{9, 18, 27, 36},
-		{9, 18, 19, 28, 37},
-		{9, 10, 19, 28, 37, 38},
-		{8, 16, 24, 32, 40},
-		{8, 16, 24, 33, 41},
-		{8, 17, 25, 33, 42},
-		{8, 17, 25, 34, 42},
-		{9, 17, 26, 35, 43},
-		{9, 18, 26, 35, 44},
-		{9, 18, 27, 36, 45},
-		{9, 18, 27, 28, 37, 46},
-		{8, 16, 24, 32, 40, 48},
-		{8, 16, 24, 33, 41, 49},
-		{8, 17, 25, 33, 41, 50},
-		{8, 17, 25, 34, 42, 51},
-		{9, 17, 26, 34, 43, 51},
-		{9, 17, 26, 35, 44, 52},
-		{9, 18, 27, 35, 44, 53},
-		{9, 18, 27, 36, 45, 54},
-	},
-	{
-		{254},
-		{255},
-		{254},
-		{2},
-		{2, 3},
-		{2, 3, 4},
-		{2, 3, 4, 5},
-		{2, 3, 4, 5, 6},
-		{254},
-		{254},
-		{254},
-		{2},
-		{2, 11},
-		{2, 3, 12},
-		{2, 3, 12, 13},
-		{2, 3, 4, 13, 14},
-		{9},
-		{9},
-		{9},
-		{10},
-		{10, 11},
-		{2, 11, 12},
-		{2, 11, 12, 21},
-		{2, 11, 12, 13, 22},
-		{9, 16},
-		{9, 17},
-		{9, 18},
-		{10, 18},
-		{10, 19},
-		{10, 11, 20},
-		{10, 11, 20, 21},
-		{2, 11, 12, 21, 22},
-		{9, 17, 24},
-		{9, 17, 25},
-		{9, 17, 26},
-		{9, 18, 26},
-		{10, 18, 27},
-		{10, 19, 28},
-		{10, 19, 20, 29},
-		{10, 11, 20, 29, 30},
-		{9, 17, 24, 32},
-		{9, 17, 25, 33},
-		{9, 17, 26, 34},
-		{9, 18, 26, 35},
-		{10, 18, 27, 35},
-		{10, 19, 27, 36},
-		{10, 19, 28, 37},
-		{10, 19, 20, 29, 38},
-		{9, 17, 25, 32, 40},
-		{9, 17, 25, 33, 41},
-		{9, 17, 25, 34, 42},
-		{9, 18, 26, 34, 43},
-		{9, 18, 26, 35, 43},
-		{10, 18, 27, 36, 44},
-		{10, 19, 27, 36, 45},
-		{10, 19, 28, 37, 46},
-		{9, 17, 25, 32, 40, 48},
-		{9, 17, 25, 33, 41, 49},
-		{9, 17, 25, 34, 42, 50},
-		{9, 18, 26, 34, 42, 51},
-		{9, 18, 26, 35, 43, 52},
-		{10, 18, 27, 35, 44, 52},
-		{10, 18, 27, 36, 45, 53},
-		{10, 19, 28, 36, 45, 54},
-	},
-	{
-		{1},
-		{254},
-		{255},
-		{254},
-		{3},
-		{3, 4},
-		{3, 4, 5},
-		{3, 4, 5, 6},
-		{1},
-		{254},
-		{254},
-		{254},
-		{3},
-		{3, 12},
-		{3, 4, 13},
-		{3, 4, 13, 14},
-		{9},
-		{10},
-		{10},
-		{10},
-		{11},
-		{11, 12},
-		{3, 12, 13},
-		{3, 12, 13, 22},
-		{9, 17},
-		{10, 17},
-		{10, 18},
-		{10, 19},
-		{11, 19},
-		{11, 20},
-		{11, 12, 21},
-		{11, 12, 21, 22},
-		{10, 17, 25},
-		{10, 18, 25},
-		{10, 18, 26},
-		{10, 18, 27},
-		{10, 19, 27},
-		{11, 19, 28},
-		{11, 20, 29},
-		{11, 20, 21, 30},
-		{10, 17, 25, 32},
-		{10, 18, 25, 33},
-		{10, 18, 26, 34},
-		{10, 18, 27, 35},
-		{10, 19, 27, 36},
-		{11, 19, 28, 36},
-		{11, 20, 28, 37},
-		{11, 20, 29, 38},
-		{10, 17, 25, 33, 40},
-		{10, 18, 26, 33, 41},
-		{10, 18, 26, 34, 42},
-		{10, 18, 26, 35, 43},
-		{10, 19, 27, 35, 44},
-		{10, 19, 27, 36, 44},
-		{11, 19, 28, 37, 45},
-		{11, 20, 28, 37, 46},
-		{10, 17, 25, 33, 41, 48},
-		{10, 18, 26, 33, 41, 49},
-		{10, 18, 26, 34, 42, 50},
-		{10, 18, 26, 35, 43, 51},
-		{10, 19, 27, 35, 43, 52},
-		{10, 19, 27, 36, 44, 53},
-		{11, 19, 28, 36, 45, 53},
-		{11, 19, 28, 37, 46, 54},
-	},
-	{
-		{2, 1},
-		{2},
-		{254},
-		{255},
-		{254},
-		{4},
-		{4, 5},
-		{4, 5, 6},
-		{2, 9},
-		{2},
-		{254},
-		{254},
-		{254},
-		{4},
-		{4, 13},
-		{4, 5, 14},
-		{10, 9},
-		{10},
-		{11},
-		{11},
-		{11},
-		{12},
-		{12, 13},
-		{4, 13, 14},
-		{10, 17},
-		{10, 18},
-		{11, 18},
-		{11, 19},
-		{11, 20},
-		{12, 20},
-		{12, 21},
-		{12, 13, 22},
-		{10, 18, 25},
-		{11, 18, 26},
-		{11, 19, 26},
-		{11, 19, 27},
-		{11, 19, 28},
-		{11, 20, 28},
-		{12, 20, 29},
-		{12, 21, 30},
-		{10, 18, 25, 33},
-		{11, 18, 26, 33},
-		{11, 19, 26, 34},
-		{11, 19, 27, 35},
-		{11, 19, 28, 36},
-		{11, 20, 28, 37},
-		{12, 20, 29, 37},
-		{12, 21, 29, 38},
-		{11, 18, 26, 33, 41},
-		{11, 18, 26, 34, 41},
-		{11, 19, 27, 34, 42},
-		{11, 19, 27, 35, 43},
-		{11, 19, 27, 36, 44},
-		{11, 20, 28, 36, 45},
-		{11, 20, 28, 37, 45},
-		{12, 20, 29, 38, 46},
-		{11, 18, 26, 33, 41, 48},
-		{11, 18, 26, 34, 42, 49},
-		{11, 19, 27, 34, 42, 50},
-		{11, 19, 27, 35, 43, 51},
-		{11, 19, 27, 36, 44, 52},
-		{11, 20, 28, 36, 44, 53},
-		{11, 20, 28, 37, 45, 54},
-		{12, 20, 29, 37, 46, 54},
-	},
-	{
-		{3, 2, 1},
-		{3, 2},
-		{3},
-		{254},
-		{255},
-		{254},
-		{5},
-		{5, 6},
-		{3, 2, 9},
-		{3, 10},
-		{3},
-		{254},
-		{254},
-		{254},
-		{5},
-		{5, 14},
-		{3, 10, 9},
-		{11, 10},
-		{11},
-		{12},
-		{12},
-		{12},
-		{13},
-		{13, 14},
-		{11, 10, 17},
-		{11, 18},
-		{11, 19},
-		{12, 19},
-		{12, 20},
-		{12, 21},
-		{13, 21},
-		{13, 22},
-		{11, 18, 25},
-		{11, 19, 26},
-		{12, 19, 27},
-		{12, 20, 27},
-		{12, 20, 28},
-		{12, 20, 29},
-		{12, 21, 29},
-		{13, 21, 30},
-		{11, 18, 26, 33},
-		{11, 19, 26, 34},
-		{12, 19, 27, 34},
-		{12, 20, 27, 35},
-		{12, 20, 28, 36},
-		{12, 20, 29, 37},
-		{12, 21, 29, 38},
-		{13, 21, 30, 38},
-		{11, 19, 26, 33, 41},
-		{12, 19, 27, 34, 42},
-		{12, 19, 27, 35, 42},
-		{12, 20, 28, 35, 43},
-		{12, 20, 28, 36, 44},
-		{12, 20, 28, 37, 45},
-		{12, 21, 29, 37, 46},
-		{12, 21, 29, 38, 46},
-		{11, 19, 26, 34, 41, 49},
-		{12, 19, 27, 34, 42, 49},
-		{12, 19, 27, 35, 43, 50},
-		{12, 20, 28, 35, 43, 51},
-		{12, 20, 28, 36, 44, 52},
-		{12, 20, 28, 37, 45, 53},
-		{12, 21, 29, 37, 45, 54},
-		{12, 21, 29, 38, 46, 55},
-	},
-	{
-		{4, 3, 2, 1},
-		{4, 3, 2},
-		{4, 3},
-		{4},
-		{254},
-		{255},
-		{254},
-		{6},
-		{4, 3, 10, 9},
-		{4, 3, 10},
-		{4, 11},
-		{4},
-		{254},
-		{254},
-		{254},
-		{6},
-		{4, 11, 10, 17},
-		{4, 11, 10},
-		{12, 11},
-		{12},
-		{13},
-		{13},
-		{13},
-		{14},
-		{12, 11, 18, 17},
-		{12, 11, 18},
-		{12, 19},
-		{12, 20},
-		{13, 20},
-		{13, 21},
-		{13, 22},
-		{14, 22},
-		{12, 19, 18, 25},
-		{12, 19, 26},
-		{12, 20, 27},
-		{13, 20, 28},
-		{13, 21, 28},
-		{13, 21, 29},
-		{13, 21, 30},
-		{13, 22, 30},
-		{12, 19, 26, 33},
-		{12, 19, 27, 34},
-		{12, 20, 27, 35},
-		{13, 20, 28, 35},
-		{13, 21, 28, 36},
-		{13, 21, 29, 37},
-		{13, 21, 30, 38},
-		{13, 22, 30, 39},
-		{12, 19, 27, 34, 41},
-		{12, 20, 27, 34, 42},
-		{13, 20, 28, 35, 43},
-		{13, 20, 28, 36, 43},
-		{13, 21, 29, 36, 44},
-		{13, 21, 29, 37, 45},
-		{13, 21, 29, 38, 46},
-		{13, 22, 30, 38, 47},
-		{12, 20, 27, 34, 41, 49},
-		{12, 20, 27, 35, 42, 50},
-		{13, 20, 28, 35, 43, 50},
-		{13, 20, 28, 36, 44, 51},
-		{13, 21, 29, 36, 44, 52},
-		{13, 21, 29, 37, 45, 53},
-		{13, 21, 29, 38, 46, 54},
-		{13, 22, 30, 38, 46, 55},
-	},
-	{
-		{5, 4, 3, 2, 1},
-		{5, 4, 3, 2},
-		{5, 4, 3},
-		{5, 4},
-		{5},
-		{254},
-		{255},
-		{254},
-		{5, 4, 3, 10, 9},
-		{5, 4, 11, 10},
-		{5, 4, 11},
-		{5, 12},
-		{5},
-		{254},
-		{254},
-		{254},
-		{5, 12, 11, 10, 17},
-		{5, 12, 11, 18},
-		{5, 12, 11},
-		{13, 12},
-		{13},
-		{14},
-		{14},
-		{14},
-		{5, 12, 11, 18, 17},
-		{13, 12, 19, 18},
-		{13, 12, 19},
-		{13, 20},
-		{13, 21},
-		{14, 21},
-		{14, 22},
-		{14, 23},
-		{13, 12, 19, 26, 25},
-		{13, 20, 19, 26},
-		{13, 20, 27},
-		{13, 21, 28},
-		{14, 21, 29},
-		{14, 22, 29},
-		{14, 22, 30},
-		{14, 22, 31},
-		{13, 20, 19, 26, 33},
-		{13, 20, 27, 34},
-		{13, 20, 28, 35},
-		{13, 21, 28, 36},
-		{14, 21, 29, 36},
-		{14, 22, 29, 37},
-		{14, 22, 30, 38},
-		{14, 22, 31, 39},
-		{13, 20, 27, 34, 41},
-		{13, 20, 28, 35, 42},
-		{13, 21, 28, 35, 43},
-		{14, 21, 29, 36, 44},
-		{14, 21, 29, 37, 44},
-		{14, 22, 30, 37, 45},
-		{14, 22, 30, 38, 46},
-		{14, 22, 30, 39, 47},
-		{13, 20, 27, 35, 42, 49},
-		{13, 21, 28, 35, 42, 50},
-		{13, 21, 28, 36, 43, 51},
-		{14, 21, 29, 36, 44, 51},
-		{14, 21, 29, 37, 45, 52},
-		{14, 22, 30, 37, 45, 53},
-		{14, 22, 30, 38, 46, 54},
-		{14, 22, 30, 39, 47, 55},
-	},
-	{
-		{6, 5, 4, 3, 2, 1},
-		{6, 5, 4, 3, 2},
-		{6, 5, 4, 3},
-		{6, 5, 4},
-		{6, 5},
-		{6},
-		{254},
-		{255},
-		{6, 5, 4, 11, 10, 9},
-		{6, 5, 4, 11, 10},
-		{6, 5, 12, 11},
-		{6, 5, 12},
-		{6, 13},
-		{6},
-		{254},
-		{254},
-		{6, 13, 12, 11, 10, 17},
-		{6, 13, 12, 11, 18},
-		{6, 13, 12, 19},
-		{6, 13, 12},
-		{14, 13},
-		{14},
-		{15},
-		{15},
-		{6, 13, 12, 19, 18, 25},
-		{6, 13, 12, 19, 18},
-		{14, 13, 20, 19},
-		{14, 13, 20},
-		{14, 21},
-		{14, 22},
-		{15, 22},
-		{15, 23},
-		{14, 13, 20, 19, 26, 25},
-		{14, 13, 20, 27, 26},
-		{14, 21, 20, 27},
-		{14, 21, 28},
-		{14, 22, 29},
-		{15, 22, 30},
-		{15, 23, 30},
-		{15, 23, 31},
-		{14, 13, 20, 27, 34, 33},
-		{14, 21, 20, 27, 34},
-		{14, 21, 28, 35},
-		{14, 21, 29, 36},
-		{14, 22, 29, 37},
-		{15, 22, 30, 37},
-		{15, 23, 30, 38},
-		{15, 23, 31, 39},
-		{14, 21, 28, 27, 34, 41},
-		{14, 21, 28, 35, 42},
-		{14, 21, 29, 36, 43},
-		{14, 22, 29, 36, 44},
-		{15, 22, 30, 37, 45},
-		{15, 22, 30, 38, 45},
-		{15, 23, 31, 38, 46},
-		{15, 23, 31, 39, 47},
-		{14, 21, 28, 35, 42, 49},
-		{14, 21, 28, 36, 43, 50},
-		{14, 22, 29, 36, 43, 51},
-		{14, 22, 29, 37, 44, 52},
-		{15, 22, 30, 37, 45, 52},
-		{15, 22, 30, 38, 46, 53},
-		{15, 23, 31, 38, 46, 54},
-		{15, 23, 31, 39, 47, 55},
-	},
-	{
-		{254},
-		{254},
-		{9},
-		{9, 2},
-		{9, 10, 3},
-		{9, 10, 3, 4},
-		{9, 10, 11, 4, 5},
-		{9, 10, 11, 4, 5, 6},
-		{255},
-		{254},
-		{9},
-		{9, 10},
-		{9, 10, 11},
-		{9, 10, 11, 12},
-		{9, 10, 11, 12, 13},
-		{9, 10, 11, 12, 13, 14},
-		{254},
-		{254},
-		{9},
-		{9, 18},
-		{9, 10, 19},
-		{9, 10, 19, 20},
-		{9, 10, 11, 20, 21},
-		{9, 10, 11, 20, 21, 22},
-		{16},
-		{16},
-		{17},
-		{17, 18},
-		{9, 18, 19},
-		{9, 18, 19, 28},
-		{9, 18, 19, 20, 29},
-		{9, 18, 19, 20, 21, 30},
-		{16, 24},
-		{16, 25},
-		{17, 25},
-		{17, 26},
-		{17, 18, 27},
-		{17, 18, 27, 28},
-		{9, 18, 19, 28, 29},
-		{9, 18, 19, 28, 29, 38},
-		{16, 24, 32},
-		{16, 24, 33},
-		{16, 25, 33},
-		{17, 25, 34},
-		{17, 26, 35},
-		{17, 26, 27, 36},
-		{17, 18, 27, 36, 37},
-		{17, 18, 27, 28, 37, 38},
-		{16, 24, 32, 40},
-		{16, 24, 33, 41},
-		{16, 25, 33, 42},
-		{17, 25, 34, 42},
-		{17, 26, 34, 43},
-		{17, 26, 35, 44},
-		{17, 26, 27, 36, 45},
-		{17, 18, 27, 36, 45, 46},
-		{16, 24, 32, 40, 48},
-		{16, 24, 32, 41, 49},
-		{16, 25, 33, 41, 50},
-		{16, 25, 33, 42, 50},
-		{17, 25, 34, 43, 51},
-		{17, 26, 34, 43, 52},
-		{17, 26, 35, 44, 53},
-		{17, 26, 35, 36, 45, 54},
-	},
-	{
-		{254},
-		{254},
-		{254},
-		{10},
-		{10, 3},
-		{10, 11, 4},
-		{10, 11, 4, 5},
-		{10, 11, 12, 5, 6},
-		{254},
-		{255},
-		{254},
-		{10},
-		{10, 11},
-		{10, 11, 12},
-		{10, 11, 12, 13},
-		{10, 11, 12, 13, 14},
-		{254},
-		{254},
-		{254},
-		{10},
-		{10, 19},
-		{10, 11, 20},
-		{10, 11, 20, 21},
-		{10, 11, 12, 21, 22},
-		{17},
-		{17},
-		{17},
-		{18},
-		{18, 19},
-		{10, 19, 20},
-		{10, 19, 20, 29},
-		{10, 19, 20, 21, 30},
-		{17, 24},
-		{17, 25},
-		{17, 26},
-		{18, 26},
-		{18, 27},
-		{18, 19, 28},
-		{18, 19, 28, 29},
-		{10, 19, 20, 29, 30},
-		{17, 25, 32},
-		{17, 25, 33},
-		{17, 25, 34},
-		{17, 26, 34},
-		{18, 26, 35},
-		{18, 27, 36},
-		{18, 27, 28, 37},
-		{18, 19, 28, 37, 38},
-		{17, 25, 32, 40},
-		{17, 25, 33, 41},
-		{17, 25, 34, 42},
-		{17, 26, 34, 43},
-		{18, 26, 35, 43},
-		{18, 27, 35, 44},
-		{18, 27, 36, 45},
-		{18, 27, 28, 37, 46},
-		{17, 25, 33, 40, 48},
-		{17, 25, 33, 41, 49},
-		{17, 25, 33, 42, 50},
-		{17, 26, 34, 42, 51},
-		{17, 26, 34, 43, 51},
-		{18, 26, 35, 44, 52},
-		{18, 27, 35, 44, 53},
-		{18, 27, 36, 45, 54},
-	},
-	{
-		{9},
-		{254},
-		{254},
-		{254},
-		{11},
-		{11, 4},
-		{11, 12, 5},
-		{11, 12, 5, 6},
-		{9},
-		{254},
-		{255},
-		{254},
-		{11},
-		{11, 12},
-		{11, 12, 13},
-		{11, 12, 13, 14},
-		{9},
-		{254},
-		{254},
-		{254},
-		{11},
-		{11, 20},
-		{11, 12, 21},
-		{11, 12, 21, 22},
-		{17},
-		{18},
-		{18},
-		{18},
-		{19},
-		{19, 20},
-		{11, 20, 21},
-		{11, 20, 21, 30},
-		{17, 25},
-		{18, 25},
-		{18, 26},
-		{18, 27},
-		{19, 27},
-		{19, 28},
-		{19, 20, 29},
-		{19, 20, 29, 30},
-		{18, 25, 33},
-		{18, 26, 33},
-		{18, 26, 34},
-		{18, 26, 35},
-		{18, 27, 35},
-		{19, 27, 36},
-		{19, 28, 37},
-		{19, 28, 29, 38},
-		{18, 25, 33, 40},
-		{18, 26, 33, 41},
-		{18, 26, 34, 42},
-		{18, 26, 35, 43},
-		{18, 27, 35, 44},
-		{19, 27, 36, 44},
-		{19, 28, 36, 45},
-		{19, 28, 37, 46},
-		{18, 25, 33, 41, 48},
-		{18, 26, 34, 41, 49},
-		{18, 26, 34, 42, 50},
-		{18, 26, 34, 43, 51},
-		{18, 27, 35, 43, 52},
-		{18, 27, 35, 44, 52},
-		{19, 27, 36, 45, 53},
-		{19, 28, 36, 45, 54},
-	},
-	{
-		{10, 1},
-		{10},
-		{254},
-		{254},
-		{254},
-		{12},
-		{12, 5},
-		{12, 13, 6},
-		{10, 9},
-		{10},
-		{254},
-		{255},
-		{254},
-		{12},
-		{12, 13},
-		{12, 13, 14},
-		{10, 17},
-		{10},
-		{254},
-		{254},
-		{254},
-		{12},
-		{12, 21},
-		{12, 13, 22},
-		{18, 17},
-		{18},
-		{19},
-		{19},
-		{19},
-		{20},
-		{20, 21},
-		{12, 21, 22},
-		{18, 25},
-		{18, 26},
-		{19, 26},
-		{19, 27},
-		{19, 28},
-		{20, 28},
-		{20, 29},
-		{20, 21, 30},
-		{18, 26, 33},
-		{19, 26, 34},
-		{19, 27, 34},
-		{19, 27, 35},
-		{19, 27, 36},
-		{19, 28, 36},
-		{20, 28, 37},
-		{20, 29, 38},
-		{18, 26, 33, 41},
-		{19, 26, 34, 41},
-		{19, 27, 34, 42},
-		{19, 27, 35, 43},
-		{19, 27, 36, 44},
-		{19, 28, 36, 45},
-		{20, 28, 37, 45},
-		{20, 29, 37, 46},
-		{19, 26, 34, 41, 49},
-		{19, 26, 34, 42, 49},
-		{19, 27, 35, 42, 50},
-		{19, 27, 35, 43, 51},
-		{19, 27, 35, 44, 52},
-		{19, 28, 36, 44, 53},
-		{19, 28, 36, 45, 53},
-		{20, 28, 37, 46, 54},
-	},
-	{
-		{11, 10, 1},
-		{11, 2},
-		{11},
-		{254},
-		{254},
-		{254},
-		{13},
-		{13, 6},
-		{11, 10, 9},
-		{11, 10},
-		{11},
-		{254},
-		{255},
-		{254},
-		{13},
-		{13, 14},
-		{11, 10, 17},
-		{11, 18},
-		{11},
-		{254},
-		{254},
-		{254},
-		{13},
-		{13, 22},
-		{11, 18, 17},
-		{19, 18},
-		{19},
-		{20},
-		{20},
-		{20},
-		{21},
-		{21, 22},
-		{19, 18, 25},
-		{19, 26},
-		{19, 27},
-		{20, 27},
-		{20, 28},
-		{20, 29},
-		{21, 29},
-		{21, 30},
-		{19, 26, 33},
-		{19, 27, 34},
-		{20, 27, 35},
-		{20, 28, 35},
-		{20, 28, 36},
-		{20, 28, 37},
-		{20, 29, 37},
-		{21, 29, 38},
-		{19, 26, 34, 41},
-		{19, 27, 34, 42},
-		{20, 27, 35, 42},
-		{20, 28, 35, 43},
-		{20, 28, 36, 44},
-		{20, 28, 37, 45},
-		{20, 29, 37, 46},
-		{21, 29, 38, 46},
-		{19, 27, 34, 41, 49},
-		{20, 27, 35, 42, 50},
-		{20, 27, 35, 43, 50},
-		{20, 28, 36, 43, 51},
-		{20, 28, 36, 44, 52},
-		{20, 28, 36, 45, 53},
-		{20, 29, 37, 45, 54},
-		{20, 29, 37, 46, 54},
-	},
-	{
-		{12, 11, 2, 1},
-		{12, 11, 2},
-		{12, 3},
-		{12},
-		{254},
-		{254},
-		{254},
-		{14},
-		{12, 11, 10, 9},
-		{12, 11, 10},
-		{12, 11},
-		{12},
-		{254},
-		{255},
-		{254},
-		{14},
-		{12, 11, 18, 17},
-		{12, 11, 18},
-		{12, 19},
-		{12},
-		{254},
-		{254},
-		{254},
-		{14},
-		{12, 19, 18, 25},
-		{12, 19, 18},
-		{20, 19},
-		{20},
-		{21},
-		{21},
-		{21},
-		{22},
-		{20, 19, 26, 25},
-		{20, 19, 26},
-		{20, 27},
-		{20, 28},
-		{21, 28},
-		{21, 29},
-		{21, 30},
-		{22, 30},
-		{20, 27, 26, 33},
-		{20, 27, 34},
-		{20, 28, 35},
-		{21, 28, 36},
-		{21, 29, 36},
-		{21, 29, 37},
-		{21, 29, 38},
-		{21, 30, 38},
-		{20, 27, 34, 41},
-		{20, 27, 35, 42},
-		{20, 28, 35, 43},
-		{21, 28, 36, 43},
-		{21, 29, 36, 44},
-		{21, 29, 37, 45},
-		{21, 29, 38, 46},
-		{21, 30, 38, 47},
-		{20, 27, 35, 42, 49},
-		{20, 28, 35, 42, 50},
-		{21, 28, 36, 43, 51},
-		{21, 28, 36, 44, 51},
-		{21, 29, 37, 44, 52},
-		{21, 29, 37, 45, 53},
-		{21, 29, 37, 46, 54},
-		{21, 30, 38, 46, 55},
-	},
-	{
-		{13, 12, 11, 2, 1},
-		{13, 12, 3, 2},
-		{13, 12, 3},
-		{13, 4},
-		{13},
-		{254},
-		{254},
-		{254},
-		{13, 12, 11, 10, 9},
-		{13, 12, 11, 10},
-		{13, 12, 11},
-		{13, 12},
-		{13},
-		{254},
-		{255},
-		{254},
-		{13, 12, 11, 18, 17},
-		{13, 12, 19, 18},
-		{13, 12, 19},
-		{13, 20},
-		{13},
-		{254},
-		{254},
-		{254},
-		{13, 20, 19, 18, 25},
-		{13, 20, 19, 26},
-		{13, 20, 19},
-		{21, 20},
-		{21},
-		{22},
-		{22},
-		{22},
-		{13, 20, 19, 26, 25},
-		{21, 20, 27, 26},
-		{21, 20, 27},
-		{21, 28},
-		{21, 29},
-		{22, 29},
-		{22, 30},
-		{22, 31},
-		{21, 20, 27, 34, 33},
-		{21, 28, 27, 34},
-		{21, 28, 35},
-		{21, 29, 36},
-		{22, 29, 37},
-		{22, 30, 37},
-		{22, 30, 38},
-		{22, 30, 39},
-		{21, 28, 27, 34, 41},
-		{21, 28, 35, 42},
-		{21, 28, 36, 43},
-		{21, 29, 36, 44},
-		{22, 29, 37, 44},
-		{22, 30, 37, 45},
-		{22, 30, 38, 46},
-		{22, 30, 39, 47},
-		{21, 28, 35, 42, 49},
-		{21, 28, 36, 43, 50},
-		{21, 29, 36, 43, 51},
-		{22, 29, 37, 44, 52},
-		{22, 29, 37, 45, 52},
-		{22, 30, 38, 45, 53},
-		{22, 30, 38, 46, 54},
-		{22, 30, 38, 47, 55},
-	},
-	{
-		{14, 13, 12, 3, 2, 1},
-		{14, 13, 12, 3, 2},
-		{14, 13, 4, 3},
-		{14, 13, 4},
-		{14, 5},
-		{14},
-		{254},
-		{254},
-		{14, 13, 12, 11, 10, 9},
-		{14, 13, 12, 11, 10},
-		{14, 13, 12, 11},
-		{14, 13, 12},
-		{14, 13},
-		{14},
-		{254},
-		{255},
-		{14, 13, 12, 19, 18, 17},
-		{14, 13, 12, 19, 18},
-		{14, 13, 20, 19},
-		{14, 13, 20},
-		{14, 21},
-		{14},
-		{254},
-		{254},
-		{14, 21, 20, 19, 18, 25},
-		{14, 21, 20, 19, 26},
-		{14, 21, 20, 27},
-		{14, 21, 20},
-		{22, 21},
-		{22},
-		{23},
-		{23},
-		{14, 21, 20, 27, 26, 33},
-		{14, 21, 20, 27, 26},
-		{22, 21, 28, 27},
-		{22, 21, 28},
-		{22, 29},
-		{22, 30},
-		{23, 30},
-		{23, 31},
-		{22, 21, 28, 27, 34, 33},
-		{22, 21, 28, 35, 34},
-		{22, 29, 28, 35},
-		{22, 29, 36},
-		{22, 30, 37},
-		{23, 30, 38},
-		{23, 31, 38},
-		{23, 31, 39},
-		{22, 21, 28, 35, 42, 41},
-		{22, 29, 28, 35, 42},
-		{22, 29, 36, 43},
-		{22, 29, 37, 44},
-		{22, 30, 37, 45},
-		{23, 30, 38, 45},
-		{23, 31, 38, 46},
-		{23, 31, 39, 47},
-		{22, 29, 36, 35, 42, 49},
-		{22, 29, 36, 43, 50},
-		{22, 29, 37, 44, 51},
-		{22, 30, 37, 44, 52},
-		{23, 30, 38, 45, 53},
-		{23, 30, 38, 46, 53},
-		{23, 31, 39, 46, 54},
-		{23, 31, 39, 47, 55},
-	},
-	{
-		{8},
-		{8},
-		{9},
-		{9, 10},
-		{17, 10, 11},
-		{17, 10, 11, 4},
-		{17, 10, 11, 12, 5},
-		{17, 10, 11, 12, 13, 6},
-		{254},
-		{254},
-		{17},
-		{17, 10},
-		{17, 18, 11},
-		{17, 18, 11, 12},
-		{17, 18, 19, 12, 13},
-		{17, 18, 19, 12, 13, 14},
-		{255},
-		{254},
-		{17},
-		{17, 18},
-		{17, 18, 19},
-		{17, 18, 19, 20},
-		{17, 18, 19, 20, 21},
-		{17, 18, 19, 20, 21, 22},
-		{254},
-		{254},
-		{17},
-		{17, 26},
-		{17, 18, 27},
-		{17, 18, 27, 28},
-		{17, 18, 19, 28, 29},
-		{17, 18, 19, 28, 29, 30},
-		{24},
-		{24},
-		{25},
-		{25, 26},
-		{17, 26, 27},
-		{17, 26, 27, 36},
-		{17, 26, 27, 28, 37},
-		{17, 26, 27, 28, 29, 38},
-		{24, 32},
-		{24, 33},
-		{25, 33},
-		{25, 34},
-		{25, 26, 35},
-		{25, 26, 35, 36},
-		{17, 26, 27, 36, 37},
-		{17, 26, 27, 36, 37, 46},
-		{24, 32, 40},
-		{24, 32, 41},
-		{24, 33, 41},
-		{25, 33, 42},
-		{25, 34, 43},
-		{25, 34, 35, 44},
-		{25, 26, 35, 44, 45},
-		{25, 26, 35, 36, 45, 46},
-		{24, 32, 40, 48},
-		{24, 32, 41, 49},
-		{24, 33, 41, 50},
-		{25, 33, 42, 50},
-		{25, 34, 42, 51},
-		{25, 34, 43, 52},
-		{25, 34, 35, 44, 53},
-		{25, 26, 35, 44, 53, 54},
-	},
-	{
-		{9},
-		{9},
-		{9},
-		{10},
-		{10, 11},
-		{18, 11, 12},
-		{18, 11, 12, 5},
-		{18, 11, 12, 13, 6},
-		{254},
-		{254},
-		{254},
-		{18},
-		{18, 11},
-		{18, 19, 12},
-		{18, 19, 12, 13},
-		{18, 19, 20, 13, 14},
-		{254},
-		{255},
-		{254},
-		{18},
-		{18, 19},
-		{18, 19, 20},
-		{18, 19, 20, 21},
-		{18, 19, 20, 21, 22},
-		{254},
-		{254},
-		{254},
-		{18},
-		{18, 27},
-		{18, 19, 28},
-		{18, 19, 28, 29},
-		{18, 19, 20, 29, 30},
-		{25},
-		{25},
-		{25},
-		{26},
-		{26, 27},
-		{18, 27, 28},
-		{18, 27, 28, 37},
-		{18, 27, 28, 29, 38},
-		{25, 32},
-		{25, 33},
-		{25, 34},
-		{26, 34},
-		{26, 35},
-		{26, 27, 36},
-		{26, 27, 36, 37},
-		{18, 27, 28, 37, 38},
-		{25, 33, 40},
-		{25, 33, 41},
-		{25, 33, 42},
-		{25, 34, 42},
-		{26, 34, 43},
-		{26, 35, 44},
-		{26, 35, 36, 45},
-		{26, 27, 36, 45, 46},
-		{25, 33, 40, 48},
-		{25, 33, 41, 49},
-		{25, 33, 42, 50},
-		{25, 34, 42, 51},
-		{26, 34, 43, 51},
-		{26, 35, 43, 52},
-		{26, 35, 44, 53},
-		{26, 35, 36, 45, 54},
-	},
-	{
-		{9},
-		{10},
-		{10},
-		{10},
-		{11},
-		{11, 12},
-		{19, 12, 13},
-		{19, 12, 13, 6},
-		{17},
-		{254},
-		{254},
-		{254},
-		{19},
-		{19, 12},
-		{19, 20, 13},
-		{19, 20, 13, 14},
-		{17},
-		{254},
-		{255},
-		{254},
-		{19},
-		{19, 20},
-		{19, 20, 21},
-		{19, 20, 21, 22},
-		{17},
-		{254},
-		{254},
-		{254},
-		{19},
-		{19, 28},
-		{19, 20, 29},
-		{19, 20, 29, 30},
-		{25},
-		{26},
-		{26},
-		{26},
-		{27},
-		{27, 28},
-		{19, 28, 29},
-		{19, 28, 29, 38},
-		{25, 33},
-		{26, 33},
-		{26, 34},
-		{26, 35},
-		{27, 35},
-		{27, 36},
-		{27, 28, 37},
-		{27, 28, 37, 38},
-		{26, 33, 41},
-		{26, 34, 41},
-		{26, 34, 42},
-		{26, 34, 43},
-		{26, 35, 43},
-		{27, 35, 44},
-		{27, 36, 45},
-		{27, 36, 37, 46},
-		{26, 33, 41, 48},
-		{26, 34, 41, 49},
-		{26, 34, 42, 50},
-		{26, 34, 43, 51},
-		{26, 35, 43, 52},
-		{27, 35, 44, 52},
-		{27, 36, 44, 53},
-		{27, 36, 45, 54},
-	},
-	{
-		{10, 9},
-		{10},
-		{11},
-		{11},
-		{11},
-		{12},
-		{12, 13},
-		{20, 13, 14},
-		{18, 9},
-		{18},
-		{254},
-		{254},
-		{254},
-		{20},
-		{20, 13},
-		{20, 21, 14},
-		{18, 17},
-		{18},
-		{254},
-		{255},
-		{254},
-		{20},
-		{20, 21},
-		{20, 21, 22},
-		{18, 25},
-		{18},
-		{254},
-		{254},
-		{254},
-		{20},
-		{20, 29},
-		{20, 21, 30},
-		{26, 25},
-		{26},
-		{27},
-		{27},
-		{27},
-		{28},
-		{28, 29},
-		{20, 29, 30},
-		{26, 33},
-		{26, 34},
-		{27, 34},
-		{27, 35},
-		{27, 36},
-		{28, 36},
-		{28, 37},
-		{28, 29, 38},
-		{26, 34, 41},
-		{27, 34, 42},
-		{27, 35, 42},
-		{27, 35, 43},
-		{27, 35, 44},
-		{27, 36, 44},
-		{28, 36, 45},
-		{28, 37, 46},
-		{26, 34, 41, 49},
-		{27, 34, 42, 49},
-		{27, 35, 42, 50},
-		{27, 35, 43, 51},
-		{27, 35, 44, 52},
-		{27, 36, 44, 53},
-		{28, 36, 45, 53},
-		{28, 37, 45, 54},
-	},
-	{
-		{19, 10, 9},
-		{11, 10},
-		{11},
-		{12},
-		{12},
-		{12},
-		{13},
-		{13, 14},
-		{19, 18, 9},
-		{19, 10},
-		{19},
-		{254},
-		{254},
-		{254},
-		{21},
-		{21, 14},
-		{19, 18, 17},
-		{19, 18},
-		{19},
-		{254},
-		{255},
-		{254},
-		{21},
-		{21, 22},
-		{19, 18, 25},
-		{19, 26},
-		{19},
-		{254},
-		{254},
-		{254},
-		{21},
-		{21, 30},
-		{19, 26, 25},
-		{27, 26},
-		{27},
-		{28},
-		{28},
-		{28},
-		{29},
-		{29, 30},
-		{27, 26, 33},
-		{27, 34},
-		{27, 35},
-		{28, 35},
-		{28, 36},
-		{28, 37},
-		{29, 37},
-		{29, 38},
-		{27, 34, 41},
-		{27, 35, 42},
-		{28, 35, 43},
-		{28, 36, 43},
-		{28, 36, 44},
-		{28, 36, 45},
-		{28, 37, 45},
-		{29, 37, 46},
-		{27, 34, 42, 49},
-		{27, 35, 42, 50},
-		{28, 35, 43, 50},
-		{28, 36, 43, 51},
-		{28, 36, 44, 52},
-		{28, 36, 45, 53},
-		{28, 37, 45, 54},
-		{29, 37, 46, 54},
-	},
-	{
-		{20, 11, 10, 1},
-		{20, 11, 10},
-		{12, 11},
-		{12},
-		{13},
-		{13},
-		{13},
-		{14},
-		{20, 19, 10, 9},
-		{20, 19, 10},
-		{20, 11},
-		{20},
-		{254},
-		{254},
-		{254},
-		{22},
-		{20, 19, 18, 17},
-		{20, 19, 18},
-		{20, 19},
-		{20},
-		{254},
-		{255},
-		{254},
-		{22},
-		{20, 19, 26, 25},
-		{20, 19, 26},
-		{20, 27},
-		{20},
-		{254},
-		{254},
-		{254},
-		{22},
-		{20, 27, 26, 33},
-		{20, 27, 26},
-		{28, 27},
-		{28},
-		{29},
-		{29},
-		{29},
-		{30},
-		{28, 27, 34, 33},
-		{28, 27, 34},
-		{28, 35},
-		{28, 36},
-		{29, 36},
-		{29, 37},
-		{29, 38},
-		{30, 38},
-		{28, 35, 34, 41},
-		{28, 35, 42},
-		{28, 36, 43},
-		{29, 36, 44},
-		{29, 37, 44},
-		{29, 37, 45},
-		{29, 37, 46},
-		{29, 38, 46},
-		{28, 35, 42, 49},
-		{28, 35, 43, 50},
-		{28, 36, 43, 51},
-		{29, 36, 44, 51},
-		{29, 37, 44, 52},
-		{29, 37, 45, 53},
-		{29, 37, 46, 54},
-		{29, 38, 46, 55},
-	},
-	{
-		{21, 12, 11, 10, 1},
-		{21, 12, 11, 2},
-		{21, 12, 11},
-		{13, 12},
-		{13},
-		{14},
-		{14},
-		{14},
-		{21, 20, 19, 10, 9},
-		{21, 20, 11, 10},
-		{21, 20, 11},
-		{21, 12},
-		{21},
-		{254},
-		{254},
-		{254},
-		{21, 20, 19, 18, 17},
-		{21, 20, 19, 18},
-		{21, 20, 19},
-		{21, 20},
-		{21},
-		{254},
-		{255},
-		{254},
-		{21, 20, 19, 26, 25},
-		{21, 20, 27, 26},
-		{21, 20, 27},
-		{21, 28},
-		{21},
-		{254},
-		{254},
-		{254},
-		{21, 28, 27, 26, 33},
-		{21, 28, 27, 34},
-		{21, 28, 27},
-		{29, 28},
-		{29},
-		{30},
-		{30},
-		{30},
-		{21, 28, 27, 34, 33},
-		{29, 28, 35, 34},
-		{29, 28, 35},
-		{29, 36},
-		{29, 37},
-		{30, 37},
-		{30, 38},
-		{30, 39},
-		{29, 28, 35, 42, 41},
-		{29, 36, 35, 42},
-		{29, 36, 43},
-		{29, 37, 44},
-		{30, 37, 45},
-		{30, 38, 45},
-		{30, 38, 46},
-		{30, 38, 47},
-		{29, 36, 35, 42, 49},
-		{29, 36, 43, 50},
-		{29, 36, 44, 51},
-		{29, 37, 44, 52},
-		{30, 37, 45, 52},
-		{30, 38, 45, 53},
-		{30, 38, 46, 54},
-		{30, 38, 47, 55},
-	},
-	{
-		{22, 13, 12, 11, 10, 1},
-		{22, 13, 12, 11, 2},
-		{22, 13, 12, 3},
-		{22, 13, 12},
-		{14, 13},
-		{14},
-		{15},
-		{15},
-		{22, 21, 20, 11, 10, 9},
-		{22, 21, 20, 11, 10},
-		{22, 21, 12, 11},
-		{22, 21, 12},
-		{22, 13},
-		{22},
-		{254},
-		{254},
-		{22, 21, 20, 19, 18, 17},
-		{22, 21, 20, 19, 18},
-		{22, 21, 20, 19},
-		{22, 21, 20},
-		{22, 21},
-		{22},
-		{254},
-		{255},
-		{22, 21, 20, 27, 26, 25},
-		{22, 21, 20, 27, 26},
-		{22, 21, 28, 27},
-		{22, 21, 28},
-		{22, 29},
-		{22},
-		{254},
-		{254},
-		{22, 29, 28, 27, 26, 33},
-		{22, 29, 28, 27, 34},
-		{22, 29, 28, 35},
-		{22, 29, 28},
-		{30, 29},
-		{30},
-		{31},
-		{31},
-		{22, 29, 28, 35, 34, 41},
-		{22, 29, 28, 35, 34},
-		{30, 29, 36, 35},
-		{30, 29, 36},
-		{30, 37},
-		{30, 38},
-		{31, 38},
-		{31, 39},
-		{30, 29, 36, 35, 42, 41},
-		{30, 29, 36, 43, 42},
-		{30, 37, 36, 43},
-		{30, 37, 44},
-		{30, 38, 45},
-		{31, 38, 46},
-		{31, 39, 46},
-		{31, 39, 47},
-		{30, 29, 36, 43, 50, 49},
-		{30, 37, 36, 43, 50},
-		{30, 37, 44, 51},
-		{30, 37, 45, 52},
-		{30, 38, 45, 53},
-		{31, 38, 46, 53},
-		{31, 39, 46, 54},
-		{31, 39, 47, 55},
-	},
-	{
-		{16, 8},
-		{16, 9},
-		{17, 9},
-		{17, 10},
-		{17, 18, 11},
-		{17, 18, 11, 12},
-		{25, 18, 19, 12, 13},
-		{25, 18, 19, 12, 13, 6},
-		{16},
-		{16},
-		{17},
-		{17, 18},
-		{25, 18, 19},
-		{25, 18, 19, 12},
-		{25, 18, 19, 20, 13},
-		{25, 18, 19, 20, 21, 14},
-		{254},
-		{254},
-		{25},
-		{25, 18},
-		{25, 26, 19},
-		{25, 26, 19, 20},
-		{25, 26, 27, 20, 21},
-		{25, 26, 27, 20, 21, 22},
-		{255},
-		{254},
-		{25},
-		{25, 26},
-		{25, 26, 27},
-		{25, 26, 27, 28},
-		{25, 26, 27, 28, 29},
-		{25, 26, 27, 28, 29, 30},
-		{254},
-		{254},
-		{25},
-		{25, 34},
-		{25, 26, 35},
-		{25, 26, 35, 36},
-		{25, 26, 27, 36, 37},
-		{25, 26, 27, 36, 37, 38},
-		{32},
-		{32},
-		{33},
-		{33, 34},
-		{25, 34, 35},
-		{25, 34, 35, 44},
-		{25, 34, 35, 36, 45},
-		{25, 34, 35, 36, 37, 46},
-		{32, 40},
-		{32, 41},
-		{33, 41},
-		{33, 42},
-		{33, 34, 43},
-		{33, 34, 43, 44},
-		{25, 34, 35, 44, 45},
-		{25, 34, 35, 44, 45, 54},
-		{32, 40, 48},
-		{32, 40, 49},
-		{32, 41, 49},
-		{33, 41, 50},
-		{33, 42, 51},
-		{33, 42, 43, 52},
-		{33, 34, 43, 52, 53},
-		{33, 34, 43, 44, 53, 54},
-	},
-	{
-		{17, 8},
-		{17, 9},
-		{17, 10},
-		{18, 10},
-		{18, 11},
-		{18, 19, 12},
-		{18, 19, 12, 13},
-		{26, 19, 20, 13, 14},
-		{17},
-		{17},
-		{17},
-		{18},
-		{18, 19},
-		{26, 19, 20},
-		{26, 19, 20, 13},
-		{26, 19, 20, 21, 14},
-		{254},
-		{254},
-		{254},
-		{26},
-		{26, 19},
-		{26, 27, 20},
-		{26, 27, 20, 21},
-		{26, 27, 28, 21, 22},
-		{254},
-		{255},
-		{254},
-		{26},
-		{26, 27},
-		{26, 27, 28},
-		{26, 27, 28, 29},
-		{26, 27, 28, 29, 30},
-		{254},
-		{254},
-		{254},
-		{26},
-		{26, 35},
-		{26, 27, 36},
-		{26, 27, 36, 37},
-		{26, 27, 28, 37, 38},
-		{33},
-		{33},
-		{33},
-		{34},
-		{34, 35},
-		{26, 35, 36},
-		{26, 35, 36, 45},
-		{26, 35, 36, 37, 46},
-		{33, 40},
-		{33, 41},
-		{33, 42},
-		{34, 42},
-		{34, 43},
-		{34, 35, 44},
-		{34, 35, 44, 45},
-		{26, 35, 36, 45, 46},
-		{33, 41, 48},
-		{33, 41, 49},
-		{33, 41, 50},
-		{33, 42, 50},
-		{34, 42, 51},
-		{34, 43, 52},
-		{34, 43, 44, 53},
-		{34, 35, 44, 53, 54},
-	},
-	{
-		{17, 9},
-		{18, 9},
-		{18, 10},
-		{18, 11},
-		{19, 11},
-		{19, 12},
-		{19, 20, 13},
-		{19, 20, 13, 14},
-		{17},
-		{18},
-		{18},
-		{18},
-		{19},
-		{19, 20},
-		{27, 20, 21},
-		{27, 20, 21, 14},
-		{25},
-		{254},
-		{254},
-		{254},
-		{27},
-		{27, 20},
-		{27, 28, 21},
-		{27, 28, 21, 22},
-		{25},
-		{254},
-		{255},
-		{254},
-		{27},
-		{27, 28},
-		{27, 28, 29},
-		{27, 28, 29, 30},
-		{25},
-		{254},
-		{254},
-		{254},
-		{27},
-		{27, 36},
-		{27, 28, 37},
-		{27, 28, 37, 38},
-		{33},
-		{34},
-		{34},
-		{34},
-		{35},
-		{35, 36},
-		{27, 36, 37},
-		{27, 36, 37, 46},
-		{33, 41},
-		{34, 41},
-		{34, 42},
-		{34, 43},
-		{35, 43},
-		{35, 44},
-		{35, 36, 45},
-		{35, 36, 45, 46},
-		{34, 41, 49},
-		{34, 42, 49},
-		{34, 42, 50},
-		{34, 42, 51},
-		{34, 43, 51},
-		{35, 43, 52},
-		{35, 44, 53},
-		{35, 44, 45, 54},
-	},
-	{
-		{18, 9},
-		{18, 10},
-		{19, 10},
-		{19, 11},
-		{19, 12},
-		{20, 12},
-		{20, 13},
-		{20, 21, 14},
-		{18, 17},
-		{18},
-		{19},
-		{19},
-		{19},
-		{20},
-		{20, 21},
-		{28, 21, 22},
-		{26, 17},
-		{26},
-		{254},
-		{254},
-		{254},
-		{28},
-		{28, 21},
-		{28, 29, 22},
-		{26, 25},
-		{26},
-		{254},
-		{255},
-		{254},
-		{28},
-		{28, 29},
-		{28, 29, 30},
-		{26, 33},
-		{26},
-		{254},
-		{254},
-		{254},
-		{28},
-		{28, 37},
-		{28, 29, 38},
-		{34, 33},
-		{34},
-		{35},
-		{35},
-		{35},
-		{36},
-		{36, 37},
-		{28, 37, 38},
-		{34, 41},
-		{34, 42},
-		{35, 42},
-		{35, 43},
-		{35, 44},
-		{36, 44},
-		{36, 45},
-		{36, 37, 46},
-		{34, 42, 49},
-		{35, 42, 50},
-		{35, 43, 50},
-		{35, 43, 51},
-		{35, 43, 52},
-		{35, 44, 52},
-		{36, 44, 53},
-		{36, 45, 54},
-	},
-	{
-		{19, 18, 9},
-		{19, 10},
-		{19, 11},
-		{20, 11},
-		{20, 12},
-		{20, 13},
-		{21, 13},
-		{21, 14},
-		{27, 18, 17},
-		{19, 18},
-		{19},
-		{20},
-		{20},
-		{20},
-		{21},
-		{21, 22},
-		{27, 26, 17},
-		{27, 18},
-		{27},
-		{254},
-		{254},
-		{254},
-		{29},
-		{29, 22},
-		{27, 26, 25},
-		{27, 26},
-		{27},
-		{254},
-		{255},
-		{254},
-		{29},
-		{29, 30},
-		{27, 26, 33},
-		{27, 34},
-		{27},
-		{254},
-		{254},
-		{254},
-		{29},
-		{29, 38},
-		{27, 34, 33},
-		{35, 34},
-		{35},
-		{36},
-		{36},
-		{36},
-		{37},
-		{37, 38},
-		{35, 34, 41},
-		{35, 42},
-		{35, 43},
-		{36, 43},
-		{36, 44},
-		{36, 45},
-		{37, 45},
-		{37, 46},
-		{35, 42, 49},
-		{35, 43, 50},
-		{36, 43, 51},
-		{36, 44, 51},
-		{36, 44, 52},
-		{36, 44, 53},
-		{36, 45, 53},
-		{37, 45, 54},
-	},
-	{
-		{20, 19, 10, 9},
-		{20, 19, 10},
-		{20, 11},
-		{20, 12},
-		{21, 12},
-		{21, 13},
-		{21, 14},
-		{22, 14},
-		{28, 19, 18, 9},
-		{28, 19, 18},
-		{20, 19},
-		{20},
-		{21},
-		{21},
-		{21},
-		{22},
-		{28, 27, 18, 17},
-		{28, 27, 18},
-		{28, 19},
-		{28},
-		{254},
-		{254},
-		{254},
-		{30},
-		{28, 27, 26, 25},
-		{28, 27, 26},
-		{28, 27},
-		{28},
-		{254},
-		{255},
-		{254},
-		{30},
-		{28, 27, 34, 33},
-		{28, 27, 34},
-		{28, 35},
-		{28},
-		{254},
-		{254},
-		{254},
-		{30},
-		{28, 35, 34, 41},
-		{28, 35, 34},
-		{36, 35},
-		{36},
-		{37},
-		{37},
-		{37},
-		{38},
-		{36, 35, 42, 41},
-		{36, 35, 42},
-		{36, 43},
-		{36, 44},
-		{37, 44},
-		{37, 45},
-		{37, 46},
-		{38, 46},
-		{36, 43, 42, 49},
-		{36, 43, 50},
-		{36, 44, 51},
-		{37, 44, 52},
-		{37, 45, 52},
-		{37, 45, 53},
-		{37, 45, 54},
-		{37, 46, 54},
-	},
-	{
-		{29, 20, 19, 10, 9},
-		{21, 20, 11, 10},
-		{21, 20, 11},
-		{21, 12},
-		{21, 13},
-		{22, 13},
-		{22, 14},
-		{22, 15},
-		{29, 20, 19, 18, 9},
-		{29, 20, 19, 10},
-		{29, 20, 19},
-		{21, 20},
-		{21},
-		{22},
-		{22},
-		{22},
-		{29, 28, 27, 18, 17},
-		{29, 28, 19, 18},
-		{29, 28, 19},
-		{29, 20},
-		{29},
-		{254},
-		{254},
-		{254},
-		{29, 28, 27, 26, 25},
-		{29, 28, 27, 26},
-		{29, 28, 27},
-		{29, 28},
-		{29},
-		{254},
-		{255},
-		{254},
-		{29, 28, 27, 34, 33},
-		{29, 28, 35, 34},
-		{29, 28, 35},
-		{29, 36},
-		{29},
-		{254},
-		{254},
-		{254},
-		{29, 36, 35, 34, 41},
-		{29, 36, 35, 42},
-		{29, 36, 35},
-		{37, 36},
-		{37},
-		{38},
-		{38},
-		{38},
-		{29, 36, 35, 42, 41},
-		{37, 36, 43, 42},
-		{37, 36, 43},
-		{37, 44},
-		{37, 45},
-		{38, 45},
-		{38, 46},
-		{38, 47},
-		{37, 36, 43, 50, 49},
-		{37, 44, 43, 50},
-		{37, 44, 51},
-		{37, 45, 52},
-		{38, 45, 53},
-		{38, 46, 53},
-		{38, 46, 54},
-		{38, 46, 55},
-	},
-	{
-		{30, 21, 20, 11, 10, 1},
-		{30, 21, 20, 11, 10},
-		{22, 21, 12, 11},
-		{22, 21, 12},
-		{22, 13},
-		{22, 14},
-		{23, 14},
-		{23, 15},
-		{30, 21, 20, 19, 18, 9},
-		{30, 21, 20, 19, 10},
-		{30, 21, 20, 11},
-		{30, 21, 20},
-		{22, 21},
-		{22},
-		{23},
-		{23},
-		{30, 29, 28, 19, 18, 17},
-		{30, 29, 28, 19, 18},
-		{30, 29, 20, 19},
-		{30, 29, 20},
-		{30, 21},
-		{30},
-		{254},
-		{254},
-		{30, 29, 28, 27, 26, 25},
-		{30, 29, 28, 27, 26},
-		{30, 29, 28, 27},
-		{30, 29, 28},
-		{30, 29},
-		{30},
-		{254},
-		{255},
-		{30, 29, 28, 35, 34, 33},
-		{30, 29, 28, 35, 34},
-		{30, 29, 36, 35},
-		{30, 29, 36},
-		{30, 37},
-		{30},
-		{254},
-		{254},
-		{30, 37, 36, 35, 34, 41},
-		{30, 37, 36, 35, 42},
-		{30, 37, 36, 43},
-		{30, 37, 36},
-		{38, 37},
-		{38},
-		{39},
-		{39},
-		{30, 37, 36, 43, 42, 49},
-		{30, 37, 36, 43, 42},
-		{38, 37, 44, 43},
-		{38, 37, 44},
-		{38, 45},
-		{38, 46},
-		{39, 46},
-		{39, 47},
-		{38, 37, 44, 43, 50, 49},
-		{38, 37, 44, 51, 50},
-		{38, 45, 44, 51},
-		{38, 45, 52},
-		{38, 46, 53},
-		{39, 46, 54},
-		{39, 47, 54},
-		{39, 47, 55},
-	},
-	{
-		{24, 16, 8},
-		{24, 16, 9},
-		{24, 17, 9},
-		{25, 17, 10},
-		{25, 18, 11},
-		{25, 18, 19, 12},
-		{25, 26, 19, 12, 13},
-		{25, 26, 19, 20, 13, 14},
-		{24, 16},
-		{24, 17},
-		{25, 17},
-		{25, 18},
-		{25, 26, 19},
-		{25, 26, 19, 20},
-		{33, 26, 27, 20, 21},
-		{33, 26, 27, 20, 21, 14},
-		{24},
-		{24},
-		{25},
-		{25, 26},
-		{33, 26, 27},
-		{33, 26, 27, 20},
-		{33, 26, 27, 28, 21},
-		{33, 26, 27, 28, 29, 22},
-		{254},
-		{254},
-		{33},
-		{33, 26},
-		{33, 34, 27},
-		{33, 34, 27, 28},
-		{33, 34, 35, 28, 29},
-		{33, 34, 35, 28, 29, 30},
-		{255},
-		{254},
-		{33},
-		{33, 34},
-		{33, 34, 35},
-		{33, 34, 35, 36},
-		{33, 34, 35, 36, 37},
-		{33, 34, 35, 36, 37, 38},
-		{254},
-		{254},
-		{33},
-		{33, 42},
-		{33, 34, 43},
-		{33, 34, 43, 44},
-		{33, 34, 35, 44, 45},
-		{33, 34, 35, 44, 45, 46},
-		{40},
-		{40},
-		{41},
-		{41, 42},
-		{33, 42, 43},
-		{33, 42, 43, 52},
-		{33, 42, 43, 44, 53},
-		{33, 42, 43, 44, 45, 54},
-		{40, 48},
-		{40, 49},
-		{41, 49},
-		{41, 50},
-		{41, 42, 51},
-		{41, 42, 51, 52},
-		{33, 42, 43, 52, 53},
-		{33, 42, 43, 52, 53, 62},
-	},
-	{
-		{25, 17, 8},
-		{25, 17, 9},
-		{25, 17, 10},
-		{25, 18, 10},
-		{26, 18, 11},
-		{26, 19, 12},
-		{26, 19, 20, 13},
-		{26, 27, 20, 13, 14},
-		{25, 16},
-		{25, 17},
-		{25, 18},
-		{26, 18},
-		{26, 19},
-		{26, 27, 20},
-		{26, 27, 20, 21},
-		{34, 27, 28, 21, 22},
-		{25},
-		{25},
-		{25},
-		{26},
-		{26, 27},
-		{34, 27, 28},
-		{34, 27, 28, 21},
-		{34, 27, 28, 29, 22},
-		{254},
-		{254},
-		{254},
-		{34},
-		{34, 27},
-		{34, 35, 28},
-		{34, 35, 28, 29},
-		{34, 35, 36, 29, 30},
-		{254},
-		{255},
-		{254},
-		{34},
-		{34, 35},
-		{34, 35, 36},
-		{34, 35, 36, 37},
-		{34, 35, 36, 37, 38},
-		{254},
-		{254},
-		{254},
-		{34},
-		{34, 43},
-		{34, 35, 44},
-		{34, 35, 44, 45},
-		{34, 35, 36, 45, 46},
-		{41},
-		{41},
-		{41},
-		{42},
-		{42, 43},
-		{34, 43, 44},
-		{34, 43, 44, 53},
-		{34, 43, 44, 45, 54},
-		{41, 48},
-		{41, 49},
-		{41, 50},
-		{42, 50},
-		{42, 51},
-		{42, 43, 52},
-		{42, 43, 52, 53},
-		{34, 43, 44, 53, 54},
-	},
-	{
-		{26, 17, 9},
-		{26, 18, 9},
-		{26, 18, 10},
-		{26, 18, 11},
-		{26, 19, 11},
-		{27, 19, 12},
-		{27, 20, 13},
-		{27, 20, 21, 14},
-		{25, 17},
-		{26, 17},
-		{26, 18},
-		{26, 19},
-		{27, 19},
-		{27, 20},
-		{27, 28, 21},
-		{27, 28, 21, 22},
-		{25},
-		{26},
-		{26},
-		{26},
-		{27},
-		{27, 28},
-		{35, 28, 29},
-		{35, 28, 29, 22},
-		{33},
-		{254},
-		{254},
-		{254},
-		{35},
-		{35, 28},
-		{35, 36, 29},
-		{35, 36, 29, 30},
-		{33},
-		{254},
-		{255},
-		{254},
-		{35},
-		{35, 36},
-		{35, 36, 37},
-		{35, 36, 37, 38},
-		{33},
-		{254},
-		{254},
-		{254},
-		{35},
-		{35, 44},
-		{35, 36, 45},
-		{35, 36, 45, 46},
-		{41},
-		{42},
-		{42},
-		{42},
-		{43},
-		{43, 44},
-		{35, 44, 45},
-		{35, 44, 45, 54},
-		{41, 49},
-		{42, 49},
-		{42, 50},
-		{42, 51},
-		{43, 51},
-		{43, 52},
-		{43, 44, 53},
-		{43, 44, 53, 54},
-	},
-	{
-		{26, 18, 9},
-		{27, 18, 10},
-		{27, 19, 10},
-		{27, 19, 11},
-		{27, 19, 12},
-		{27, 20, 12},
-		{28, 20, 13},
-		{28, 21, 14},
-		{26, 17},
-		{26, 18},
-		{27, 18},
-		{27, 19},
-		{27, 20},
-		{28, 20},
-		{28, 21},
-		{28, 29, 22},
-		{26, 25},
-		{26},
-		{27},
-		{27},
-		{27},
-		{28},
-		{28, 29},
-		{36, 29, 30},
-		{34, 25},
-		{34},
-		{254},
-		{254},
-		{254},
-		{36},
-		{36, 29},
-		{36, 37, 30},
-		{34, 33},
-		{34},
-		{254},
-		{255},
-		{254},
-		{36},
-		{36, 37},
-		{36, 37, 38},
-		{34, 41},
-		{34},
-		{254},
-		{254},
-		{254},
-		{36},
-		{36, 45},
-		{36, 37, 46},
-		{42, 41},
-		{42},
-		{43},
-		{43},
-		{43},
-		{44},
-		{44, 45},
-		{36, 45, 46},
-		{42, 49},
-		{42, 50},
-		{43, 50},
-		{43, 51},
-		{43, 52},
-		{44, 52},
-		{44, 53},
-		{44, 45, 54},
-	},
-	{
-		{27, 18, 9},
-		{27, 19, 10},
-		{28, 19, 11},
-		{28, 20, 11},
-		{28, 20, 12},
-		{28, 20, 13},
-		{28, 21, 13},
-		{29, 21, 14},
-		{27, 26, 17},
-		{27, 18},
-		{27, 19},
-		{28, 19},
-		{28, 20},
-		{28, 21},
-		{29, 21},
-		{29, 22},
-		{35, 26, 25},
-		{27, 26},
-		{27},
-		{28},
-		{28},
-		{28},
-		{29},
-		{29, 30},
-		{35, 34, 25},
-		{35, 26},
-		{35},
-		{254},
-		{255},
-		{254},
-		{37},
-		{37, 30},
-		{35, 34, 33},
-		{35, 34},
-		{35},
-		{254},
-		{254},
-		{254},
-		{37},
-		{37, 38},
-		{35, 34, 41},
-		{35, 42},
-		{35},
-		{254},
-		{254},
-		{254},
-		{37},
-		{37, 46},
-		{35, 42, 41},
-		{43, 42},
-		{43},
-		{44},
-		{44},
-		{44},
-		{45},
-		{45, 46},
-		{43, 42, 49},
-		{43, 50},
-		{43, 51},
-		{44, 51},
-		{44, 52},
-		{44, 53},
-		{45, 53},
-		{45, 54},
-	},
-	{
-		{28, 19, 18, 9},
-		{28, 19, 10},
-		{28, 20, 11},
-		{29, 20, 12},
-		{29, 21, 12},
-		{29, 21, 13},
-		{29, 21, 14},
-		{29, 22, 14},
-		{28, 27, 18, 17},
-		{28, 27, 18},
-		{28, 19},
-		{28, 20},
-		{29, 20},
-		{29, 21},
-		{29, 22},
-		{30, 22},
-		{36, 27, 26, 17},
-		{36, 27, 26},
-		{28, 27},
-		{28},
-		{29},
-		{29},
-		{29},
-		{30},
-		{36, 35, 26, 25},
-		{36, 35, 26},
-		{36, 27},
-		{36},
-		{254},
-		{254},
-		{254},
-		{38},
-		{36, 35, 34, 33},
-		{36, 35, 34},
-		{36, 35},
-		{36},
-		{254},
-		{255},
-		{254},
-		{38},
-		{36, 35, 42, 41},
-		{36, 35, 42},
-		{36, 43},
-		{36},
-		{254},
-		{254},
-		{254},
-		{38},
-		{36, 43, 42, 49},
-		{36, 43, 42},
-		{44, 43},
-		{44},
-		{45},
-		{45},
-		{45},
-		{46},
-		{44, 43, 50, 49},
-		{44, 43, 50},
-		{44, 51},
-		{44, 52},
-		{45, 52},
-		{45, 53},
-		{45, 54},
-		{46, 54},
-	},
-	{
-		{29, 28, 19, 10, 9},
-		{29, 20, 19, 10},
-		{29, 20, 11},
-		{29, 21, 12},
-		{30, 21, 13},
-		{30, 22, 13},
-		{30, 22, 14},
-		{30, 22, 15},
-		{37, 28, 27, 18, 17},
-		{29, 28, 19, 18},
-		{29, 28, 19},
-		{29, 20},
-		{29, 21},
-		{30, 21},
-		{30, 22},
-		{30, 23},
-		{37, 28, 27, 26, 17},
-		{37, 28, 27, 18},
-		{37, 28, 27},
-		{29, 28},
-		{29},
-		{30},
-		{30},
-		{30},
-		{37, 36, 35, 26, 25},
-		{37, 36, 27, 26},
-		{37, 36, 27},
-		{37, 28},
-		{37},
-		{254},
-		{254},
-		{254},
-		{37, 36, 35, 34, 33},
-		{37, 36, 35, 34},
-		{37, 36, 35},
-		{37, 36},
-		{37},
-		{254},
-		{255},
-		{254},
-		{37, 36, 35, 42, 41},
-		{37, 36, 43, 42},
-		{37, 36, 43},
-		{37, 44},
-		{37},
-		{254},
-		{254},
-		{254},
-		{37, 44, 43, 42, 49},
-		{37, 44, 43, 50},
-		{37, 44, 43},
-		{45, 44},
-		{45},
-		{46},
-		{46},
-		{46},
-		{37, 44, 43, 50, 49},
-		{45, 44, 51, 50},
-		{45, 44, 51},
-		{45, 52},
-		{45, 53},
-		{46, 53},
-		{46, 54},
-		{46, 55},
-	},
-	{
-		{30, 29, 20, 19, 10, 9},
-		{30, 29, 20, 11, 10},
-		{30, 21, 20, 11},
-		{30, 21, 12},
-		{30, 22, 13},
-		{31, 22, 14},
-		{31, 23, 14},
-		{31, 23, 15},
-		{38, 29, 28, 19, 18, 9},
-		{38, 29, 28, 19, 18},
-		{30, 29, 20, 19},
-		{30, 29, 20},
-		{30, 21},
-		{30, 22},
-		{31, 22},
-		{31, 23},
-		{38, 29, 28, 27, 26, 17},
-		{38, 29, 28, 27, 18},
-		{38, 29, 28, 19},
-		{38, 29, 28},
-		{30, 29},
-		{30},
-		{31},
-		{31},
-		{38, 37, 36, 27, 26, 25},
-		{38, 37, 36, 27, 26},
-		{38, 37, 28, 27},
-		{38, 37, 28},
-		{38, 29},
-		{38},
-		{254},
-		{254},
-		{38, 37, 36, 35, 34, 33},
-		{38, 37, 36, 35, 34},
-		{38, 37, 36, 35},
-		{38, 37, 36},
-		{38, 37},
-		{38},
-		{254},
-		{255},
-		{38, 37, 36, 43, 42, 41},
-		{38, 37, 36, 43, 42},
-		{38, 37, 44, 43},
-		{38, 37, 44},
-		{38, 45},
-		{38},
-		{254},
-		{254},
-		{38, 45, 44, 43, 42, 49},
-		{38, 45, 44, 43, 50},
-		{38, 45, 44, 51},
-		{38, 45, 44},
-		{46, 45},
-		{46},
-		{47},
-		{47},
-		{38, 45, 44, 51, 50, 57},
-		{38, 45, 44, 51, 50},
-		{46, 45, 52, 51},
-		{46, 45, 52},
-		{46, 53},
-		{46, 54},
-		{47, 54},
-		{47, 55},
-	},
-	{
-		{32, 24, 16, 8},
-		{32, 24, 17, 9},
-		{32, 25, 17, 10},
-		{33, 25, 18, 10},
-		{33, 26, 18, 11},
-		{33, 26, 19, 12},
-		{33, 26, 27, 20, 13},
-		{33, 34, 27, 20, 13, 14},
-		{32, 24, 16},
-		{32, 24, 17},
-		{32, 25, 17},
-		{33, 25, 18},
-		{33, 26, 19},
-		{33, 26, 27, 20},
-		{33, 34, 27, 20, 21},
-		{33, 34, 27, 28, 21, 22},
-		{32, 24},
-		{32, 25},
-		{33, 25},
-		{33, 26},
-		{33, 34, 27},
-		{33, 34, 27, 28},
-		{41, 34, 35, 28, 29},
-		{41, 34, 35, 28, 29, 22},
-		{32},
-		{32},
-		{33},
-		{33, 34},
-		{41, 34, 35},
-		{41, 34, 35, 28},
-		{41, 34, 35, 36, 29},
-		{41, 34, 35, 36, 37, 30},
-		{254},
-		{254},
-		{41},
-		{41, 34},
-		{41, 42, 35},
-		{41, 42, 35, 36},
-		{41, 42, 43, 36, 37},
-		{41, 42, 43, 36, 37, 38},
-		{255},
-		{254},
-		{41},
-		{41, 42},
-		{41, 42, 43},
-		{41, 42, 43, 44},
-		{41, 42, 43, 44, 45},
-		{41, 42, 43, 44, 45, 46},
-		{254},
-		{254},
-		{41},
-		{41, 50},
-		{41, 42, 51},
-		{41, 42, 51, 52},
-		{41, 42, 43, 52, 53},
-		{41, 42, 43, 52, 53, 54},
-		{48},
-		{48},
-		{49},
-		{49, 50},
-		{41, 50, 51},
-		{41, 50, 51, 60},
-		{41, 50, 51, 52, 61},
-		{41, 50, 51, 52, 53, 62},
-	},
-	{
-		{33, 25, 16, 8},
-		{33, 25, 17, 9},
-		{33, 25, 18, 10},
-		{33, 26, 18, 11},
-		{34, 26, 19, 11},
-		{34, 27, 19, 12},
-		{34, 27, 20, 13},
-		{34, 27, 28, 21, 14},
-		{33, 25, 16},
-		{33, 25, 17},
-		{33, 25, 18},
-		{33, 26, 18},
-		{34, 26, 19},
-		{34, 27, 20},
-		{34, 27, 28, 21},
-		{34, 35, 28, 21, 22},
-		{33, 24},
-		{33, 25},
-		{33, 26},
-		{34, 26},
-		{34, 27},
-		{34, 35, 28},
-		{34, 35, 28, 29},
-		{42, 35, 36, 29, 30},
-		{33},
-		{33},
-		{33},
-		{34},
-		{34, 35},
-		{42, 35, 36},
-		{42, 35, 36, 29},
-		{42, 35, 36, 37, 30},
-		{254},
-		{254},
-		{254},
-		{42},
-		{42, 35},
-		{42, 43, 36},
-		{42, 43, 36, 37},
-		{42, 43, 44, 37, 38},
-		{254},
-		{255},
-		{254},
-		{42},
-		{42, 43},
-		{42, 43, 44},
-		{42, 43, 44, 45},
-		{42, 43, 44, 45, 46},
-		{254},
-		{254},
-		{254},
-		{42},
-		{42, 51},
-		{42, 43, 52},
-		{42, 43, 52, 53},
-		{42, 43, 44, 53, 54},
-		{49},
-		{49},
-		{49},
-		{50},
-		{50, 51},
-		{42, 51, 52},
-		{42, 51, 52, 61},
-		{42, 51, 52, 53, 62},
-	},
-	{
-		{34, 25, 17, 8},
-		{34, 26, 17, 9},
-		{34, 26, 18, 10},
-		{34, 26, 19, 11},
-		{34, 27, 19, 12},
-		{35, 27, 20, 12},
-		{35, 28, 20, 13},
-		{35, 28, 21, 14},
-		{34, 25, 17},
-		{34, 26, 17},
-		{34, 26, 18},
-		{34, 26, 19},
-		{34, 27, 19},
-		{35, 27, 20},
-		{35, 28, 21},
-		{35, 28, 29, 22},
-		{33, 25},
-		{34, 25},
-		{34, 26},
-		{34, 27},
-		{35, 27},
-		{35, 28},
-		{35, 36, 29},
-		{35, 36, 29, 30},
-		{33},
-		{34},
-		{34},
-		{34},
-		{35},
-		{35, 36},
-		{43, 36, 37},
-		{43, 36, 37, 30},
-		{41},
-		{254},
-		{254},
-		{254},
-		{43},
-		{43, 36},
-		{43, 44, 37},
-		{43, 44, 37, 38},
-		{41},
-		{254},
-		{255},
-		{254},
-		{43},
-		{43, 44},
-		{43, 44, 45},
-		{43, 44, 45, 46},
-		{41},
-		{254},
-		{254},
-		{254},
-		{43},
-		{43, 52},
-		{43, 44, 53},
-		{43, 44, 53, 54},
-		{49},
-		{50},
-		{50},
-		{50},
-		{51},
-		{51, 52},
-		{43, 52, 53},
-		{43, 52, 53, 62},
-	},
-	{
-		{34, 26, 17, 9},
-		{35, 26, 18, 9},
-		{35, 27, 18, 10},
-		{35, 27, 19, 11},
-		{35, 27, 20, 12},
-		{35, 28, 20, 13},
-		{36, 28, 21, 13},
-		{36, 29, 21, 14},
-		{34, 26, 17},
-		{35, 26, 18},
-		{35, 27, 18},
-		{35, 27, 19},
-		{35, 27, 20},
-		{35, 28, 20},
-		{36, 28, 21},
-		{36, 29, 22},
-		{34, 25},
-		{34, 26},
-		{35, 26},
-		{35, 27},
-		{35, 28},
-		{36, 28},
-		{36, 29},
-		{36, 37, 30},
-		{34, 33},
-		{34},
-		{35},
-		{35},
-		{35},
-		{36},
-		{36, 37},
-		{44, 37, 38},
-		{42, 33},
-		{42},
-		{254},
-		{254},
-		{254},
-		{44},
-		{44, 37},
-		{44, 45, 38},
-		{42, 41},
-		{42},
-		{254},
-		{255},
-		{254},
-		{44},
-		{44, 45},
-		{44, 45, 46},
-		{42, 49},
-		{42},
-		{254},
-		{254},
-		{254},
-		{44},
-		{44, 53},
-		{44, 45, 54},
-		{50, 49},
-		{50},
-		{51},
-		{51},
-		{51},
-		{52},
-		{52, 53},
-		{44, 53, 54},
-	},
-	{
-		{35, 26, 18, 9},
-		{35, 27, 18, 10},
-		{36, 27, 19, 10},
-		{36, 28, 19, 11},
-		{36, 28, 20, 12},
-		{36, 28, 21, 13},
-		{36, 29, 21, 14},
-		{37, 29, 22, 14},
-		{35, 26, 17},
-		{35, 27, 18},
-		{36, 27, 19},
-		{36, 28, 19},
-		{36, 28, 20},
-		{36, 28, 21},
-		{36, 29, 21},
-		{37, 29, 22},
-		{35, 34, 25},
-		{35, 26},
-		{35, 27},
-		{36, 27},
-		{36, 28},
-		{36, 29},
-		{37, 29},
-		{37, 30},
-		{43, 34, 33},
-		{35, 34},
-		{35},
-		{36},
-		{36},
-		{36},
-		{37},
-		{37, 38},
-		{43, 42, 33},
-		{43, 34},
-		{43},
-		{254},
-		{254},
-		{254},
-		{45},
-		{45, 38},
-		{43, 42, 41},
-		{43, 42},
-		{43},
-		{254},
-		{255},
-		{254},
-		{45},
-		{45, 46},
-		{43, 42, 49},
-		{43, 50},
-		{43},
-		{254},
-		{254},
-		{254},
-		{45},
-		{45, 54},
-		{43, 50, 49},
-		{51, 50},
-		{51},
-		{52},
-		{52},
-		{52},
-		{53},
-		{53, 54},
-	},
-	{
-		{36, 27, 18, 9},
-		{36, 27, 19, 10},
-		{36, 28, 19, 11},
-		{37, 28, 20, 11},
-		{37, 29, 20, 12},
-		{37, 29, 21, 13},
-		{37, 29, 22, 14},
-		{37, 30, 22, 15},
-		{36, 27, 26, 17},
-		{36, 27, 18},
-		{36, 28, 19},
-		{37, 28, 20},
-		{37, 29, 20},
-		{37, 29, 21},
-		{37, 29, 22},
-		{37, 30, 22},
-		{36, 35, 26, 25},
-		{36, 35, 26},
-		{36, 27},
-		{36, 28},
-		{37, 28},
-		{37, 29},
-		{37, 30},
-		{38, 30},
-		{44, 35, 34, 25},
-		{44, 35, 34},
-		{36, 35},
-		{36},
-		{37},
-		{37},
-		{37},
-		{38},
-		{44, 43, 34, 33},
-		{44, 43, 34},
-		{44, 35},
-		{44},
-		{254},
-		{254},
-		{254},
-		{46},
-		{44, 43, 42, 41},
-		{44, 43, 42},
-		{44, 43},
-		{44},
-		{254},
-		{255},
-		{254},
-		{46},
-		{44, 43, 50, 49},
-		{44, 43, 50},
-		{44, 51},
-		{44},
-		{254},
-		{254},
-		{254},
-		{46},
-		{44, 51, 50, 57},
-		{44, 51, 50},
-		{52, 51},
-		{52},
-		{53},
-		{53},
-		{53},
-		{54},
-	},
-	{
-		{37, 28, 27, 18, 9},
-		{37, 28, 19, 10},
-		{37, 28, 20, 11},
-		{37, 29, 20, 12},
-		{38, 29, 21, 12},
-		{38, 30, 21, 13},
-		{38, 30, 22, 14},
-		{38, 30, 23, 15},
-		{37, 36, 27, 18, 17},
-		{37, 28, 27, 18},
-		{37, 28, 19},
-		{37, 29, 20},
-		{38, 29, 21},
-		{38, 30, 21},
-		{38, 30, 22},
-		{38, 30, 23},
-		{45, 36, 35, 26, 25},
-		{37, 36, 27, 26},
-		{37, 36, 27},
-		{37, 28},
-		{37, 29},
-		{38, 29},
-		{38, 30},
-		{38, 31},
-		{45, 36, 35, 34, 25},
-		{45, 36, 35, 26},
-		{45, 36, 35},
-		{37, 36},
-		{37},
-		{38},
-		{38},
-		{38},
-		{45, 44, 43, 34, 33},
-		{45, 44, 35, 34},
-		{45, 44, 35},
-		{45, 36},
-		{45},
-		{254},
-		{254},
-		{254},
-		{45, 44, 43, 42, 41},
-		{45, 44, 43, 42},
-		{45, 44, 43},
-		{45, 44},
-		{45},
-		{254},
-		{255},
-		{254},
-		{45, 44, 43, 50, 49},
-		{45, 44, 51, 50},
-		{45, 44, 51},
-		{45, 52},
-		{45},
-		{254},
-		{254},
-		{254},
-		{45, 52, 51, 50, 57},
-		{45, 52, 51, 58},
-		{45, 52, 51},
-		{53, 52},
-		{53},
-		{54},
-		{54},
-		{54},
-	},
-	{
-		{38, 37, 28, 19, 10, 9},
-		{38, 29, 28, 19, 10},
-		{38, 29, 20, 11},
-		{38, 29, 21, 12},
-		{38, 30, 21, 13},
-		{39, 30, 22, 13},
-		{39, 31, 22, 14},
-		{39, 31, 23, 15},
-		{38, 37, 28, 27, 18, 17},
-		{38, 37, 28, 19, 18},
-		{38, 29, 28, 19},
-		{38, 29, 20},
-		{38, 30, 21},
-		{39, 30, 22},
-		{39, 31, 22},
-		{39, 31, 23},
-		{46, 37, 36, 27, 26, 17},
-		{46, 37, 36, 27, 26},
-		{38, 37, 28, 27},
-		{38, 37, 28},
-		{38, 29},
-		{38, 30},
-		{39, 30},
-		{39, 31},
-		{46, 37, 36, 35, 34, 25},
-		{46, 37, 36, 35, 26},
-		{46, 37, 36, 27},
-		{46, 37, 36},
-		{38, 37},
-		{38},
-		{39},
-		{39},
-		{46, 45, 44, 35, 34, 33},
-		{46, 45, 44, 35, 34},
-		{46, 45, 36, 35},
-		{46, 45, 36},
-		{46, 37},
-		{46},
-		{254},
-		{254},
-		{46, 45, 44, 43, 42, 41},
-		{46, 45, 44, 43, 42},
-		{46, 45, 44, 43},
-		{46, 45, 44},
-		{46, 45},
-		{46},
-		{254},
-		{255},
-		{46, 45, 44, 51, 50, 49},
-		{46, 45, 44, 51, 50},
-		{46, 45, 52, 51},
-		{46, 45, 52},
-		{46, 53},
-		{46},
-		{254},
-		{254},
-		{46, 53, 52, 51, 50, 57},
-		{46, 53, 52, 51, 58},
-		{46, 53, 52, 59},
-		{46, 53, 52},
-		{54, 53},
-		{54},
-		{55},
-		{55},
-	},
-	{
-		{40, 32, 24, 16, 8},
-		{40, 32, 24, 17, 9},
-		{40, 33, 25, 17, 10},
-		{40, 33, 25, 18, 10},
-		{41, 33, 26, 19, 11},
-		{41, 34, 26, 19, 12},
-		{41, 34, 27, 20, 13},
-		{41, 34, 27, 28, 21, 14},
-		{40, 32, 24, 16},
-		{40, 32, 25, 17},
-		{40, 33, 25, 18},
-		{41, 33, 26, 18},
-		{41, 34, 26, 19},
-		{41, 34, 27, 20},
-		{41, 34, 35, 28, 21},
-		{41, 42, 35, 28, 21, 22},
-		{40, 32, 24},
-		{40, 32, 25},
-		{40, 33, 25},
-		{41, 33, 26},
-		{41, 34, 27},
-		{41, 34, 35, 28},
-		{41, 42, 35, 28, 29},
-		{41, 42, 35, 36, 29, 30},
-		{40, 32},
-		{40, 33},
-		{41, 33},
-		{41, 34},
-		{41, 42, 35},
-		{41, 42, 35, 36},
-		{49, 42, 43, 36, 37},
-		{49, 42, 43, 36, 37, 30},
-		{40},
-		{40},
-		{41},
-		{41, 42},
-		{49, 42, 43},
-		{49, 42, 43, 36},
-		{49, 42, 43, 44, 37},
-		{49, 42, 43, 44, 45, 38},
-		{254},
-		{254},
-		{49},
-		{49, 42},
-		{49, 50, 43},
-		{49, 50, 43, 44},
-		{49, 50, 51, 44, 45},
-		{49, 50, 51, 44, 45, 46},
-		{255},
-		{254},
-		{49},
-		{49, 50},
-		{49, 50, 51},
-		{49, 50, 51, 52},
-		{49, 50, 51, 52, 53},
-		{49, 50, 51, 52, 53, 54},
-		{254},
-		{254},
-		{49},
-		{49, 58},
-		{49, 50, 59},
-		{49, 50, 59, 60},
-		{49, 50, 51, 60, 61},
-		{49, 50, 51, 60, 61, 62},
-	},
-	{
-		{41, 33, 25, 16, 8},
-		{41, 33, 25, 17, 9},
-		{41, 33, 25, 18, 10},
-		{41, 34, 26, 18, 11},
-		{41, 34, 26, 19, 11},
-		{42, 34, 27, 20, 12},
-		{42, 35, 27, 20, 13},
-		{42, 35, 28, 21, 14},
-		{41, 33, 24, 16},
-		{41, 33, 25, 17},
-		{41, 33, 26, 18},
-		{41, 34, 26, 19},
-		{42, 34, 27, 19},
-		{42, 35, 27, 20},
-		{42, 35, 28, 21},
-		{42, 35, 36, 29, 22},
-		{41, 33, 24},
-		{41, 33, 25},
-		{41, 33, 26},
-		{41, 34, 26},
-		{42, 34, 27},
-		{42, 35, 28},
-		{42, 35, 36, 29},
-		{42, 43, 36, 29, 30},
-		{41, 32},
-		{41, 33},
-		{41, 34},
-		{42, 34},
-		{42, 35},
-		{42, 43, 36},
-		{42, 43, 36, 37},
-		{50, 43, 44, 37, 38},
-		{41},
-		{41},
-		{41},
-		{42},
-		{42, 43},
-		{50, 43, 44},
-		{50, 43, 44, 37},
-		{50, 43, 44, 45, 38},
-		{254},
-		{254},
-		{254},
-		{50},
-		{50, 43},
-		{50, 51, 44},
-		{50, 51, 44, 45},
-		{50, 51, 52, 45, 46},
-		{254},
-		{255},
-		{254},
-		{50},
-		{50, 51},
-		{50, 51, 52},
-		{50, 51, 52, 53},
-		{50, 51, 52, 53, 54},
-		{254},
-		{254},
-		{254},
-		{50},
-		{50, 59},
-		{50, 51, 60},
-		{50, 51, 60, 61},
-		{50, 51, 52, 61, 62},
-	},
-	{
-		{42, 33, 25, 17, 8},
-		{42, 34, 26, 17, 9},
-		{42, 34, 26, 18, 10},
-		{42, 34, 26, 19, 11},
-		{42, 35, 27, 19, 12},
-		{42, 35, 27, 20, 12},
-		{43, 35, 28, 21, 13},
-		{43, 36, 28, 21, 14},
-		{42, 33, 25, 16},
-		{42, 34, 25, 17},
-		{42, 34, 26, 18},
-		{42, 34, 27, 19},
-		{42, 35, 27, 20},
-		{43, 35, 28, 20},
-		{43, 36, 28, 21},
-		{43, 36, 29, 22},
-		{42, 33, 25},
-		{42, 34, 25},
-		{42, 34, 26},
-		{42, 34, 27},
-		{42, 35, 27},
-		{43, 35, 28},
-		{43, 36, 29},
-		{43, 36, 37, 30},
-		{41, 33},
-		{42, 33},
-		{42, 34},
-		{42, 35},
-		{43, 35},
-		{43, 36},
-		{43, 44, 37},
-		{43, 44, 37, 38},
-		{41},
-		{42},
-		{42},
-		{42},
-		{43},
-		{43, 44},
-		{51, 44, 45},
-		{51, 44, 45, 38},
-		{49},
-		{254},
-		{254},
-		{254},
-		{51},
-		{51, 44},
-		{51, 52, 45},
-		{51, 52, 45, 46},
-		{49},
-		{254},
-		{255},
-		{254},
-		{51},
-		{51, 52},
-		{51, 52, 53},
-		{51, 52, 53, 54},
-		{49},
-		{254},
-		{254},
-		{254},
-		{51},
-		{51, 60},
-		{51, 52, 61},
-		{51, 52, 61, 62},
-	},
-	{
-		{43, 34, 26, 17, 9},
-		{43, 34, 26, 18, 9},
-		{43, 35, 27, 18, 10},
-		{43, 35, 27, 19, 11},
-		{43, 35, 27, 20, 12},
-		{43, 36, 28, 20, 13},
-		{43, 36, 28, 21, 13},
-		{44, 36, 29, 22, 14},
-		{42, 34, 25, 17},
-		{43, 34, 26, 17},
-		{43, 35, 26, 18},
-		{43, 35, 27, 19},
-		{43, 35, 28, 20},
-		{43, 36, 28, 21},
-		{44, 36, 29, 21},
-		{44, 37, 29, 22},
-		{42, 34, 25},
-		{43, 34, 26},
-		{43, 35, 26},
-		{43, 35, 27},
-		{43, 35, 28},
-		{43, 36, 28},
-		{44, 36, 29},
-		{44, 37, 30},
-		{42, 33},
-		{42, 34},
-		{43, 34},
-		{43, 35},
-		{43, 36},
-		{44, 36},
-		{44, 37},
-		{44, 45, 38},
-		{42, 41},
-		{42},
-		{43},
-		{43},
-		{43},
-		{44},
-		{44, 45},
-		{52, 45, 46},
-		{50, 41},
-		{50},
-		{254},
-		{254},
-		{254},
-		{52},
-		{52, 45},
-		{52, 53, 46},
-		{50, 49},
-		{50},
-		{254},
-		{255},
-		{254},
-		{52},
-		{52, 53},
-		{52, 53, 54},
-		{50, 57},
-		{50},
-		{254},
-		{254},
-		{254},
-		{52},
-		{52, 61},
-		{52, 53, 62},
-	},
-	{
-		{43, 35, 26, 17, 9},
-		{44, 35, 27, 18, 10},
-		{44, 35, 27, 19, 10},
-		{44, 36, 28, 19, 11},
-		{44, 36, 28, 20, 12},
-		{44, 36, 28, 21, 13},
-		{44, 37, 29, 21, 14},
-		{44, 37, 29, 22, 14},
-		{43, 34, 26, 17},
-		{43, 35, 26, 18},
-		{44, 35, 27, 18},
-		{44, 36, 27, 19},
-		{44, 36, 28, 20},
-		{44, 36, 29, 21},
-		{44, 37, 29, 22},
-		{45, 37, 30, 22},
-		{43, 34, 25},
-		{43, 35, 26},
-		{44, 35, 27},
-		{44, 36, 27},
-		{44, 36, 28},
-		{44, 36, 29},
-		{44, 37, 29},
-		{45, 37, 30},
-		{43, 42, 33},
-		{43, 34},
-		{43, 35},
-		{44, 35},
-		{44, 36},
-		{44, 37},
-		{45, 37},
-		{45, 38},
-		{51, 42, 41},
-		{43, 42},
-		{43},
-		{44},
-		{44},
-		{44},
-		{45},
-		{45, 46},
-		{51, 50, 41},
-		{51, 42},
-		{51},
-		{254},
-		{254},
-		{254},
-		{53},
-		{53, 46},
-		{51, 50, 49},
-		{51, 50},
-		{51},
-		{254},
-		{255},
-		{254},
-		{53},
-		{53, 54},
-		{51, 50, 57},
-		{51, 58},
-		{51},
-		{254},
-		{254},
-		{254},
-		{53},
-		{53, 62},
-	},
-	{
-		{44, 35, 27, 18, 9},
-		{44, 36, 27, 18, 10},
-		{45, 36, 28, 19, 11},
-		{45, 36, 28, 20, 11},
-		{45, 37, 29, 20, 12},
-		{45, 37, 29, 21, 13},
-		{45, 37, 29, 22, 14},
-		{45, 38, 30, 22, 15},
-		{44, 35, 26, 17},
-		{44, 35, 27, 18},
-		{44, 36, 27, 19},
-		{45, 36, 28, 19},
-		{45, 37, 28, 20},
-		{45, 37, 29, 21},
-		{45, 37, 30, 22},
-		{45, 38, 30, 23},
-		{44, 35, 34, 25},
-		{44, 35, 26},
-		{44, 36, 27},
-		{45, 36, 28},
-		{45, 37, 28},
-		{45, 37, 29},
-		{45, 37, 30},
-		{45, 38, 30},
-		{44, 43, 34, 33},
-		{44, 43, 34},
-		{44, 35},
-		{44, 36},
-		{45, 36},
-		{45, 37},
-		{45, 38},
-		{46, 38},
-		{52, 43, 42, 33},
-		{52, 43, 42},
-		{44, 43},
-		{44},
-		{45},
-		{45},
-		{45},
-		{46},
-		{52, 51, 42, 41},
-		{52, 51, 42},
-		{52, 43},
-		{52},
-		{254},
-		{254},
-		{254},
-		{54},
-		{52, 51, 50, 49},
-		{52, 51, 50},
-		{52, 51},
-		{52},
-		{254},
-		{255},
-		{254},
-		{54},
-		{52, 51, 58, 57},
-		{52, 51, 58},
-		{52, 59},
-		{52},
-		{254},
-		{254},
-		{254},
-		{54},
-	},
-	{
-		{45, 36, 27, 18, 9},
-		{45, 36, 28, 19, 10},
-		{45, 37, 28, 19, 11},
-		{46, 37, 29, 20, 12},
-		{46, 37, 29, 21, 12},
-		{46, 38, 30, 21, 13},
-		{46, 38, 30, 22, 14},
-		{46, 38, 30, 23, 15},
-		{45, 36, 35, 26, 17},
-		{45, 36, 27, 18},
-		{45, 36, 28, 19},
-		{45, 37, 28, 20},
-		{46, 37, 29, 20},
-		{46, 38, 29, 21},
-		{46, 38, 30, 22},
-		{46, 38, 31, 23},
-		{45, 44, 35, 26, 25},
-		{45, 36, 35, 26},
-		{45, 36, 27},
-		{45, 37, 28},
-		{46, 37, 29},
-		{46, 38, 29},
-		{46, 38, 30},
-		{46, 38, 31},
-		{53, 44, 43, 34, 33},
-		{45, 44, 35, 34},
-		{45, 44, 35},
-		{45, 36},
-		{45, 37},
-		{46, 37},
-		{46, 38},
-		{46, 39},
-		{53, 44, 43, 42, 33},
-		{53, 44, 43, 34},
-		{53, 44, 43},
-		{45, 44},
-		{45},
-		{46},
-		{46},
-		{46},
-		{53, 52, 51, 42, 41},
-		{53, 52, 43, 42},
-		{53, 52, 43},
-		{53, 44},
-		{53},
-		{254},
-		{254},
-		{254},
-		{53, 52, 51, 50, 49},
-		{53, 52, 51, 50},
-		{53, 52, 51},
-		{53, 52},
-		{53},
-		{254},
-		{255},
-		{254},
-		{53, 52, 51, 58, 57},
-		{53, 52, 59, 58},
-		{53, 52, 59},
-		{53, 60},
-		{53},
-		{254},
-		{254},
-		{254},
-	},
-	{
-		{46, 37, 28, 27, 18, 9},
-		{46, 37, 28, 19, 10},
-		{46, 37, 29, 20, 11},
-		{46, 38, 29, 20, 12},
-		{47, 38, 30, 21, 13},
-		{47, 38, 30, 22, 13},
-		{47, 39, 31, 22, 14},
-		{47, 39, 31, 23, 15},
-		{46, 45, 36, 27, 18, 17},
-		{46, 37, 36, 27, 18},
-		{46, 37, 28, 19},
-		{46, 37, 29, 20},
-		{46, 38, 29, 21},
-		{47, 38, 30, 21},
-		{47, 39, 30, 22},
-		{47, 39, 31, 23},
-		{46, 45, 36, 35, 26, 25},
-		{46, 45, 36, 27, 26},
-		{46, 37, 36, 27},
-		{46, 37, 28},
-		{46, 38, 29},
-		{47, 38, 30},
-		{47, 39, 30},
-		{47, 39, 31},
-		{54, 45, 44, 35, 34, 25},
-		{54, 45, 44, 35, 34},
-		{46, 45, 36, 35},
-		{46, 45, 36},
-		{46, 37},
-		{46, 38},
-		{47, 38},
-		{47, 39},
-		{54, 45, 44, 43, 42, 33},
-		{54, 45, 44, 43, 34},
-		{54, 45, 44, 35},
-		{54, 45, 44},
-		{46, 45},
-		{46},
-		{47},
-		{47},
-		{54, 53, 52, 43, 42, 41},
-		{54, 53, 52, 43, 42},
-		{54, 53, 44, 43},
-		{54, 53, 44},
-		{54, 45},
-		{54},
-		{254},
-		{254},
-		{54, 53, 52, 51, 50, 49},
-		{54, 53, 52, 51, 50},
-		{54, 53, 52, 51},
-		{54, 53, 52},
-		{54, 53},
-		{54},
-		{254},
-		{255},
-		{54, 53, 52, 59, 58, 57},
-		{54, 53, 52, 59, 58},
-		{54, 53, 60, 59},
-		{54, 53, 60},
-		{54, 61},
-		{54},
-		{254},
-		{254},
-	},
-	{
-		{48, 40, 32, 24, 16, 8},
-		{48, 40, 32, 25, 17, 9},
-		{48, 41, 33, 25, 17, 10},
-		{48, 41, 33, 26, 18, 11},
-		{49, 41, 34, 26, 19, 11},
-		{49, 41, 34, 27, 20, 12},
-		{49, 42, 35, 27, 20, 13},
-		{49, 42, 35, 28, 21, 14},
-		{48, 40, 32, 24, 16},
-		{48, 40, 32, 25, 17},
-		{48, 41, 33, 25, 18},
-		{48, 41, 33, 26, 18},
-		{49, 41, 34, 27, 19},
-		{49, 42, 34, 27, 20},
-		{49, 42, 35, 28, 21},
-		{49, 42, 35, 36, 29, 22},
-		{48, 40, 32, 24},
-		{48, 40, 33, 25},
-		{48, 41, 33, 26},
-		{49, 41, 34, 26},
-		{49, 42, 34, 27},
-		{49, 42, 35, 28},
-		{49, 42, 43, 36, 29},
-		{49, 50, 43, 36, 29, 30},
-		{48, 40, 32},
-		{48, 40, 33},
-		{48, 41, 33},
-		{49, 41, 34},
-		{49, 42, 35},
-		{49, 42, 43, 36},
-		{49, 50, 43, 36, 37},
-		{49, 50, 43, 44, 37, 38},
-		{48, 40},
-		{48, 41},
-		{49, 41},
-		{49, 42},
-		{49, 50, 43},
-		{49, 50, 43, 44},
-		{57, 50, 51, 44, 45},
-		{57, 50, 51, 44, 45, 38},
-		{48},
-		{48},
-		{49},
-		{49, 50},
-		{57, 50, 51},
-		{57, 50, 51, 44},
-		{57, 50, 51, 52, 45},
-		{57, 50, 51, 52, 53, 46},
-		{254},
-		{254},
-		{57},
-		{57, 50},
-		{57, 58, 51},
-		{57, 58, 51, 52},
-		{57, 58, 59, 52, 53},
-		{57, 58, 59, 52, 53, 54},
-		{255},
-		{254},
-		{57},
-		{57, 58},
-		{57, 58, 59},
-		{57, 58, 59, 60},
-		{57, 58, 59, 60, 61},
-		{57, 58, 59, 60, 61, 62},
-	},
-	{
-		{49, 41, 33, 24, 16, 8},
-		{49, 41, 33, 25, 17, 9},
-		{49, 41, 33, 26, 18, 10},
-		{49, 42, 34, 26, 18, 11},
-		{49, 42, 34, 27, 19, 12},
-		{50, 42, 35, 27, 20, 12},
-		{50, 42, 35, 28, 21, 13},
-		{50, 43, 36, 28, 21, 14},
-		{49, 41, 33, 24, 16},
-		{49, 41, 33, 25, 17},
-		{49, 41, 33, 26, 18},
-		{49, 42, 34, 26, 19},
-		{49, 42, 34, 27, 19},
-		{50, 42, 35, 28, 20},
-		{50, 43, 35, 28, 21},
-		{50, 43, 36, 29, 22},
-		{49, 41, 32, 24},
-		{49, 41, 33, 25},
-		{49, 41, 34, 26},
-		{49, 42, 34, 27},
-		{50, 42, 35, 27},
-		{50, 43, 35, 28},
-		{50, 43, 36, 29},
-		{50, 43, 44, 37, 30},
-		{49, 41, 32},
-		{49, 41, 33},
-		{49, 41, 34},
-		{49, 42, 34},
-		{50, 42, 35},
-		{50, 43, 36},
-		{50, 43, 44, 37},
-		{50, 51, 44, 37, 38},
-		{49, 40},
-		{49, 41},
-		{49, 42},
-		{50, 42},
-		{50, 43},
-		{50, 51, 44},
-		{50, 51, 44, 45},
-		{58, 51, 52, 45, 46},
-		{49},
-		{49},
-		{49},
-		{50},
-		{50, 51},
-		{58, 51, 52},
-		{58, 51, 52, 45},
-		{58, 51, 52, 53, 46},
-		{254},
-		{254},
-		{254},
-		{58},
-		{58, 51},
-		{58, 59, 52},
-		{58, 59, 52, 53},
-		{58, 59, 60, 53, 54},
-		{254},
-		{255},
-		{254},
-		{58},
-		{58, 59},
-		{58, 59, 60},
-		{58, 59, 60, 61},
-		{58, 59, 60, 61, 62},
-	},
-	{
-		{50, 41, 33, 25, 17, 8},
-		{50, 42, 34, 25, 17, 9},
-		{50, 42, 34, 26, 18, 10},
-		{50, 42, 34, 27, 19, 11},
-		{50, 43, 35, 27, 19, 12},
-		{50, 43, 35, 28, 20, 13},
-		{51, 43, 36, 28, 21, 13},
-		{51, 43, 36, 29, 22, 14},
-		{50, 41, 33, 25, 16},
-		{50, 42, 34, 25, 17},
-		{50, 42, 34, 26, 18},
-		{50, 42, 34, 27, 19},
-		{50, 43, 35, 27, 20},
-		{50, 43, 35, 28, 20},
-		{51, 43, 36, 29, 21},
-		{51, 44, 36, 29, 22},
-		{50, 41, 33, 24},
-		{50, 42, 33, 25},
-		{50, 42, 34, 26},
-		{50, 42, 35, 27},
-		{50, 43, 35, 28},
-		{51, 43, 36, 28},
-		{51, 44, 36, 29},
-		{51, 44, 37, 30},
-		{50, 41, 33},
-		{50, 42, 33},
-		{50, 42, 34},
-		{50, 42, 35},
-		{50, 43, 35},
-		{51, 43, 36},
-		{51, 44, 37},
-		{51, 44, 45, 38},
-		{49, 41},
-		{50, 41},
-		{50, 42},
-		{50, 43},
-		{51, 43},
-		{51, 44},
-		{51, 52, 45},
-		{51, 52, 45, 46},
-		{49},
-		{50},
-		{50},
-		{50},
-		{51},
-		{51, 52},
-		{59, 52, 53},
-		{59, 52, 53, 46},
-		{57},
-		{254},
-		{254},
-		{254},
-		{59},
-		{59, 52},
-		{59, 60, 53},
-		{59, 60, 53, 54},
-		{57},
-		{254},
-		{255},
-		{254},
-		{59},
-		{59, 60},
-		{59, 60, 61},
-		{59, 60, 61, 62},
-	},
-	{
-		{51, 42, 34, 25, 17, 8},
-		{51, 42, 34, 26, 18, 9},
-		{51, 43, 35, 26, 18, 10},
-		{51, 43, 35, 27, 19, 11},
-		{51, 43, 35, 28, 20, 12},
-		{51, 44, 36, 28, 20, 13},
-		{51, 44, 36, 29, 21, 14},
-		{52, 44, 37, 29, 22, 14},
-		{51, 42, 34, 25, 17},
-		{51, 42, 34, 26, 17},
-		{51, 43, 35, 26, 18},
-		{51, 43, 35, 27, 19},
-		{51, 43, 35, 28, 20},
-		{51, 44, 36, 28, 21},
-		{51, 44, 36, 29, 21},
-		{52, 44, 37, 30, 22},
-		{50, 42, 33, 25},
-		{51, 42, 34, 25},
-		{51, 43, 34, 26},
-		{51, 43, 35, 27},
-		{51, 43, 36, 28},
-		{51, 44, 36, 29},
-		{52, 44, 37, 29},
-		{52, 45, 37, 30},
-		{50, 42, 33},
-		{51, 42, 34},
-		{51, 43, 34},
-		{51, 43, 35},
-		{51, 43, 36},
-		{51, 44, 36},
-		{52, 44, 37},
-		{52, 45, 38},
-		{50, 41},
-		{50, 42},
-		{51, 42},
-		{51, 43},
-		{51, 44},
-		{52, 44},
-		{52, 45},
-		{52, 53, 46},
-		{50, 49},
-		{50},
-		{51},
-		{51},
-		{51},
-		{52},
-		{52, 53},
-		{60, 53, 54},
-		{58, 49},
-		{58},
-		{254},
-		{254},
-		{254},
-		{60},
-		{60, 53},
-		{60, 61, 54},
-		{58, 57},
-		{58},
-		{254},
-		{255},
-		{254},
-		{60},
-		{60, 61},
-		{60, 61, 62},
-	},
-	{
-		{51, 43, 34, 26, 17, 9},
-		{52, 43, 35, 26, 18, 9},
-		{52, 43, 35, 27, 19, 10},
-		{52, 44, 36, 27, 19, 11},
-		{52, 44, 36, 28, 20, 12},
-		{52, 44, 36, 29, 21, 13},
-		{52, 45, 37, 29, 21, 14},
-		{52, 45, 37, 30, 22, 15},
-		{51, 43, 34, 25, 17},
-		{52, 43, 35, 26, 18},
-		{52, 43, 35, 27, 18},
-		{52, 44, 36, 27, 19},
-		{52, 44, 36, 28, 20},
-		{52, 44, 36, 29, 21},
-		{52, 45, 37, 29, 22},
-		{52, 45, 37, 30, 22},
-		{51, 42, 34, 25},
-		{51, 43, 34, 26},
-		{52, 43, 35, 26},
-		{52, 44, 35, 27},
-		{52, 44, 36, 28},
-		{52, 44, 37, 29},
-		{52, 45, 37, 30},
-		{53, 45, 38, 30},
-		{51, 42, 33},
-		{51, 43, 34},
-		{52, 43, 35},
-		{52, 44, 35},
-		{52, 44, 36},
-		{52, 44, 37},
-		{52, 45, 37},
-		{53, 45, 38},
-		{51, 50, 41},
-		{51, 42},
-		{51, 43},
-		{52, 43},
-		{52, 44},
-		{52, 45},
-		{53, 45},
-		{53, 46},
-		{59, 50, 49},
-		{51, 50},
-		{51},
-		{52},
-		{52},
-		{52},
-		{53},
-		{53, 54},
-		{59, 58, 49},
-		{59, 50},
-		{59},
-		{254},
-		{254},
-		{254},
-		{61},
-		{61, 54},
-		{59, 58, 57},
-		{59, 58},
-		{59},
-		{254},
-		{255},
-		{254},
-		{61},
-		{61, 62},
-	},
-	{
-		{52, 44, 35, 26, 17, 9},
-		{52, 44, 35, 27, 18, 10},
-		{53, 44, 36, 27, 19, 10},
-		{53, 44, 36, 28, 20, 11},
-		{53, 45, 37, 28, 20, 12},
-		{53, 45, 37, 29, 21, 13},
-		{53, 45, 37, 30, 22, 14},
-		{53, 46, 38, 30, 22, 15},
-		{52, 43, 35, 26, 17},
-		{52, 44, 35, 26, 18},
-		{53, 44, 36, 27, 19},
-		{53, 44, 36, 28, 19},
-		{53, 45, 37, 28, 20},
-		{53, 45, 37, 29, 21},
-		{53, 45, 37, 30, 22},
-		{53, 46, 38, 30, 23},
-		{52, 43, 34, 25},
-		{52, 43, 35, 26},
-		{52, 44, 35, 27},
-		{53, 44, 36, 27},
-		{53, 45, 36, 28},
-		{53, 45, 37, 29},
-		{53, 45, 38, 30},
-		{53, 46, 38, 31},
-		{52, 43, 42, 33},
-		{52, 43, 34},
-		{52, 44, 35},
-		{53, 44, 36},
-		{53, 45, 36},
-		{53, 45, 37},
-		{53, 45, 38},
-		{53, 46, 38},
-		{52, 51, 42, 41},
-		{52, 51, 42},
-		{52, 43},
-		{52, 44},
-		{53, 44},
-		{53, 45},
-		{53, 46},
-		{54, 46},
-		{60, 51, 50, 41},
-		{60, 51, 50},
-		{52, 51},
-		{52},
-		{53},
-		{53},
-		{53},
-		{54},
-		{60, 59, 50, 49},
-		{60, 59, 50},
-		{60, 51},
-		{60},
-		{254},
-		{254},
-		{254},
-		{62},
-		{60, 59, 58, 57},
-		{60, 59, 58},
-		{60, 59},
-		{60},
-		{254},
-		{255},
-		{254},
-		{62},
-	},
-	{
-		{53, 44, 35, 27, 18, 9},
-		{53, 45, 36, 27, 18, 10},
-		{53, 45, 36, 28, 19, 11},
-		{54, 45, 37, 28, 20, 11},
-		{54, 45, 37, 29, 21, 12},
-		{54, 46, 38, 29, 21, 13},
-		{54, 46, 38, 30, 22, 14},
-		{54, 46, 38, 31, 23, 15},
-		{53, 44, 35, 26, 17},
-		{53, 44, 36, 27, 18},
-		{53, 45, 36, 27, 19},
-		{54, 45, 37, 28, 20},
-		{54, 45, 37, 29, 20},
-		{54, 46, 38, 29, 21},
-		{54, 46, 38, 30, 22},
-		{54, 46, 38, 31, 23},
-		{53, 44, 43, 34, 25},
-		{53, 44, 35, 26},
-		{53, 44, 36, 27},
-		{53, 45, 36, 28},
-		{54, 45, 37, 28},
-		{54, 46, 37, 29},
-		{54, 46, 38, 30},
-		{54, 46, 39, 31},
-		{53, 52, 43, 34, 33},
-		{53, 44, 43, 34},
-		{53, 44, 35},
-		{53, 45, 36},
-		{54, 45, 37},
-		{54, 46, 37},
-		{54, 46, 38},
-		{54, 46, 39},
-		{61, 52, 51, 42, 41},
-		{53, 52, 43, 42},
-		{53, 52, 43},
-		{53, 44},
-		{53, 45},
-		{54, 45},
-		{54, 46},
-		{54, 47},
-		{61, 52, 51, 50, 41},
-		{61, 52, 51, 42},
-		{61, 52, 51},
-		{53, 52},
-		{53},
-		{54},
-		{54},
-		{54},
-		{61, 60, 59, 50, 49},
-		{61, 60, 51, 50},
-		{61, 60, 51},
-		{61, 52},
-		{61},
-		{254},
-		{254},
-		{254},
-		{61, 60, 59, 58, 57},
-		{61, 60, 59, 58},
-		{61, 60, 59},
-		{61, 60},
-		{61},
-		{254},
-		{255},
-		{254},
-	},
-	{
-		{54, 45, 36, 27, 18, 9},
-		{54, 45, 36, 28, 19, 10},
-		{54, 46, 37, 28, 19, 11},
-		{54, 46, 37, 29, 20, 12},
-		{55, 46, 38, 29, 21, 12},
-		{55, 46, 38, 30, 22, 13},
-		{55, 47, 39, 30, 22, 14},
-		{55, 47, 39, 31, 23, 15},
-		{54, 45, 36, 35, 26, 17},
-		{54, 45, 36, 27, 18},
-		{54, 45, 37, 28, 19},
-		{54, 46, 37, 28, 20},
-		{55, 46, 38, 29, 21},
-		{55, 46, 38, 30, 21},
-		{55, 47, 39, 30, 22},
-		{55, 47, 39, 31, 23},
-		{54, 53, 44, 35, 26, 25},
-		{54, 45, 44, 35, 26},
-		{54, 45, 36, 27},
-		{54, 45, 37, 28},
-		{54, 46, 37, 29},
-		{55, 46, 38, 29},
-		{55, 47, 38, 30},
-		{55, 47, 39, 31},
-		{54, 53, 44, 43, 34, 33},
-		{54, 53, 44, 35, 34},
-		{54, 45, 44, 35},
-		{54, 45, 36},
-		{54, 46, 37},
-		{55, 46, 38},
-		{55, 47, 38},
-		{55, 47, 39},
-		{62, 53, 52, 43, 42, 33},
-		{62, 53, 52, 43, 42},
-		{54, 53, 44, 43},
-		{54, 53, 44},
-		{54, 45},
-		{54, 46},
-		{55, 46},
-		{55, 47},
-		{62, 53, 52, 51, 50, 41},
-		{62, 53, 52, 51, 42},
-		{62, 53, 52, 43},
-		{62, 53, 52},
-		{54, 53},
-		{54},
-		{55},
-		{55},
-		{62, 61, 60, 51, 50, 49},
-		{62, 61, 60, 51, 50},
-		{62, 61, 52, 51},
-		{62, 61, 52},
-		{62, 53},
-		{62},
-		{254},
-		{254},
-		{62, 61, 60, 59, 58, 57},
-		{62, 61, 60, 59, 58},
-		{62, 61, 60, 59},
-		{62, 61, 60},
-		{62, 61},
-		{62},
-		{254},
-		{255},
-	},
+import (
+	"fmt"
+	"sync"
+)
+
+// Encounters default to DefaultWidth x DefaultHeight and can be at most MaxWidth x MaxHeight.
+// Squares are indexed row by row, index = y*Width + x
+const (
+	DefaultWidth  = 8
+	DefaultHeight = 8
+	MaxWidth      = 16
+	MaxHeight     = 16
+	MaxSize       = MaxWidth * MaxHeight
+)
+
+// Markers used in a LOS line instead of squares, as there are no squares between them
+const (
+	SelfSquare     uint16 = 0xFFFF
+	NeighborSquare uint16 = 0xFFFE
+)
+
+// The precomputed tables of one board size. Neighbors are the orthogonal neighbors of every square in the
+// order left, up, down, right
+type Layout struct {
+	Width     int
+	Height    int
+	Size      int
+	Neighbors [][]uint16
+	losLines  [][]uint16 // Flattened BuildLOSLineMap, [index*Size + target]
+}
+
+var (
+	layoutsMutex sync.Mutex
+	layouts      = map[[2]int]*Layout{}
+)
+
+// Returns the tables for a width x height board, building them the first time a size is used.
+// Panics if the size is outside 1x1 to MaxWidth x MaxHeight
+func GetLayout(width, height int) *Layout {
+	if width < 1 || width > MaxWidth || height < 1 || height > MaxHeight {
+		panic(fmt.Sprintf("board size %dx%d is outside 1x1 to %dx%d", width, height, MaxWidth, MaxHeight))
+	}
+
+	layoutsMutex.Lock()
+	defer layoutsMutex.Unlock()
+
+	key := [2]int{width, height}
+	if layout, exists := layouts[key]; exists {
+		return layout
+	}
+	layout := &Layout{
+		Width:     width,
+		Height:    height,
+		Size:      width * height,
+		Neighbors: BuildNeighborMap(width, height),
+		losLines:  make([][]uint16, 0, width*height*width*height),
+	}
+	for _, lines := range BuildLOSLineMap(width, height) {
+		layout.losLines = append(layout.losLines, lines...)
+	}
+	layouts[key] = layout
+	return layout
+}
+
+// The squares on the LOS between index and target, ordered from index towards target, see BuildLOSLineMap
+func (l *Layout) LOSLine(index, target uint16) []uint16 {
+	return l.losLines[int(index)*l.Size+int(target)]
+}
+
+// Builds the LOS lines of a width x height board. The target itself and the squares around index are never
+// part of a line, so [index][index] is {SelfSquare} and any of the 8 surrounding squares is {NeighborSquare}.
+//
+// Every other line is a Bresenham line between the square centres: it steps one square at a time along the
+// axis with the larger distance and picks the nearest square on the other axis. When the line passes exactly
+// between two squares the one closer to index is picked, which keeps lines from a square towards the walls
+// hugging the row or column it starts in
+func BuildLOSLineMap(width, height int) [][][]uint16 {
+	size := width * height
+	lines := make([][][]uint16, size)
+	for index := range size {
+		lines[index] = make([][]uint16, size)
+		for target := range size {
+			lines[index][target] = losLine(width, index, target)
+		}
+	}
+	return lines
+}
+
+func losLine(width, index, target int) []uint16 {
+	x0, y0 := index%width, index/width
+	dx, dy := target%width-x0, target/width-y0
+
+	steps, minorDistance := abs(dx), abs(dy)
+	if minorDistance > steps {
+		steps, minorDistance = minorDistance, steps
+	}
+	switch {
+	case steps == 0:
+		return []uint16{SelfSquare}
+	case steps == 1:
+		return []uint16{NeighborSquare}
+	}
+
+	line := make([]uint16, 0, steps-1)
+	for step := 1; step < steps; step++ {
+		// Rounds minorDistance*step/steps to the nearest square, halves towards index
+		offset := (2*minorDistance*step + steps - 1) / (2 * steps)
+		x, y := x0, y0
+		if abs(dx) >= abs(dy) {
+			x += sign(dx) * step
+			y += sign(dy) * offset
+		} else {
+			x += sign(dx) * offset
+			y += sign(dy) * step
+		}
+		line = append(line, uint16(y*width+x))
+	}
+	return line
+}
+
+// Builds the orthogonal neighbors of every square of a width x height board
+func BuildNeighborMap(width, height int) [][]uint16 {
+	neighbors := make([][]uint16, width*height)
+	for index := range neighbors {
+		x, y := index%width, index/width
+		neighbors[index] = make([]uint16, 0, 4)
+		if x > 0 {
+			neighbors[index] = append(neighbors[index], uint16(index-1))
+		}
+		if y > 0 {
+			neighbors[index] = append(neighbors[index], uint16(index-width))
+		}
+		if y < height-1 {
+			neighbors[index] = append(neighbors[index], uint16(index+width))
+		}
+		if x < width-1 {
+			neighbors[index] = append(neighbors[index], uint16(index+1))
+		}
+	}
+	return neighbors
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
 }
 
-// Hardcoded neighbor squares for each square on the board
-var NeighborMap = [64][]uint8{
-	{8, 1},
-	{0, 9, 2},
-	{1, 10, 3},
-	{2, 11, 4},
-	{3, 12, 5},
-	{4, 13, 6},
-	{5, 14, 7},
-	{6, 15},
-	{0, 16, 9},
-	{8, 1, 17, 10},
-	{9, 2, 18, 11},
-	{10, 3, 19, 12},
-	{11, 4, 20, 13},
-	{12, 5, 21, 14},
-	{13, 6, 22, 15},
-	{14, 7, 23},
-	{8, 24, 17},
-	{16, 9, 25, 18},
-	{17, 10, 26, 19},
-	{18, 11, 27, 20},
-	{19, 12, 28, 21},
-	{20, 13, 29, 22},
-	{21, 14, 30, 23},
-	{22, 15, 31},
-	{16, 32, 25},
-	{24, 17, 33, 26},
-	{25, 18, 34, 27},
-	{26, 19, 35, 28},
-	{27, 20, 36, 29},
-	{28, 21, 37, 30},
-	{29, 22, 38, 31},
-	{30, 23, 39},
-	{24, 40, 33},
-	{32, 25, 41, 34},
-	{33, 26, 42, 35},
-	{34, 27, 43, 36},
-	{35, 28, 44, 37},
-	{36, 29, 45, 38},
-	{37, 30, 46, 39},
-	{38, 31, 47},
-	{32, 48, 41},
-	{40, 33, 49, 42},
-	{41, 34, 50, 43},
-	{42, 35, 51, 44},
-	{43, 36, 52, 45},
-	{44, 37, 53, 46},
-	{45, 38, 54, 47},
-	{46, 39, 55},
-	{40, 56, 49},
-	{48, 41, 57, 50},
-	{49, 42, 58, 51},
-	{50, 43, 59, 52},
-	{51, 44, 60, 53},
-	{52, 45, 61, 54},
-	{53, 46, 62, 55},
-	{54, 47, 63},
-	{48, 57},
-	{56, 49, 58},
-	{57, 50, 59},
-	{58, 51, 60},
-	{59, 52, 61},
-	{60, 53, 62},
-	{61, 54, 63},
-	{62, 55},
+func sign(v int) int {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	}
+	return 0
 }
@@ -0,0 +1,140 @@
+package board_map
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Reads the lines of a file in testdata, skipping comments
+func readTestdata(t *testing.T, name string) []string {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if !strings.HasPrefix(scanner.Text(), "#") {
+			lines = append(lines, scanner.Text())
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return lines
+}
+
+// Parses a comma separated list of squares, translating the legacy markers to SelfSquare and NeighborSquare
+func parseSquares(t *testing.T, list string) []uint16 {
+	t.Helper()
+	squares := []uint16{}
+	for _, field := range strings.Split(list, ",") {
+		square, err := strconv.Atoi(field)
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch square {
+		case 255:
+			squares = append(squares, SelfSquare)
+		case 254:
+			squares = append(squares, NeighborSquare)
+		default:
+			squares = append(squares, uint16(square))
+		}
+	}
+	return squares
+}
+
+// Reads testdata/los_8x8.txt, a line of squares for every index and target
+func readLegacyLOSTable(t *testing.T) [][][]uint16 {
+	t.Helper()
+	var table [][][]uint16
+	for _, line := range readTestdata(t, "los_8x8.txt") {
+		var row [][]uint16
+		for _, entry := range strings.Split(line, ";") {
+			row = append(row, parseSquares(t, entry))
+		}
+		table = append(table, row)
+	}
+	return table
+}
+
+func TestLOSLineMapMatchesLegacyTable(t *testing.T) {
+	legacy := readLegacyLOSTable(t)
+	generated := BuildLOSLineMap(8, 8)
+	if len(legacy) != 64 {
+		t.Fatalf("legacy table has %d rows, want 64", len(legacy))
+	}
+
+	// Typos in the hand written table: the self and neighbor markers of square 36 were swapped
+	intended := map[[2]int][]uint16{
+		{36, 28}: {NeighborSquare},
+		{36, 36}: {SelfSquare},
+	}
+
+	for index := range 64 {
+		for target := range 64 {
+			want, fixed := intended[[2]int{index, target}]
+			if fixed {
+				if slices.Equal(legacy[index][target], want) {
+					t.Errorf("[%d][%d] is listed as a fix but the legacy table already has %v", index, target, want)
+				}
+			} else {
+				want = legacy[index][target]
+			}
+
+			if got := generated[index][target]; !slices.Equal(got, want) {
+				t.Errorf("[%d][%d] = %v, want %v", index, target, got, want)
+			}
+		}
+	}
+}
+
+func TestNeighborMapMatchesLegacyTable(t *testing.T) {
+	legacy := readTestdata(t, "neighbors_8x8.txt")
+	generated := BuildNeighborMap(8, 8)
+	if len(legacy) != 64 || len(generated) != 64 {
+		t.Fatalf("legacy table has %d rows and the generated one %d, want 64", len(legacy), len(generated))
+	}
+
+	// Order matters as well, it decides which square CalculateRange settles first between equal costs
+	for index, line := range legacy {
+		if want := parseSquares(t, line); !slices.Equal(generated[index], want) {
+			t.Errorf("[%d] = %v, want %v", index, generated[index], want)
+		}
+	}
+}
+
+// Every size marks self and the 8 surrounding squares, other lines hold one square per step between
+func TestLOSLineMapShape(t *testing.T) {
+	for _, size := range [][2]int{{1, 1}, {3, 5}, {8, 8}, {16, 16}} {
+		width, height := size[0], size[1]
+		lines := BuildLOSLineMap(width, height)
+		for index := range width * height {
+			for target := range width * height {
+				dx, dy := abs(index%width-target%width), abs(index/width-target/width)
+				line := lines[index][target]
+				switch {
+				case dx == 0 && dy == 0:
+					if !slices.Equal(line, []uint16{SelfSquare}) {
+						t.Errorf("%dx%d [%d][%d] = %v, want self", width, height, index, target, line)
+					}
+				case dx <= 1 && dy <= 1:
+					if !slices.Equal(line, []uint16{NeighborSquare}) {
+						t.Errorf("%dx%d [%d][%d] = %v, want neighbor", width, height, index, target, line)
+					}
+				case len(line) != max(dx, dy)-1 || slices.Contains(line, uint16(target)):
+					t.Errorf("%dx%d [%d][%d] = %v, want %d squares between", width, height, index, target, line, max(dx, dy)-1)
+				}
+			}
+		}
+	}
+}
@@ -5,40 +5,239 @@ type ActionType uint8
 const (
 	MoveType ActionType = iota
 	AbilityType
+	EndTurnType
 )
 
+// An action that ends the current actor's turn, regardless of budget left
+var EndTurnAction = Action{ActionType: EndTurnType}
+
 type Action struct {
 	ActionType ActionType
-	Index      uint8
-	Target     uint8
+	Index      uint16
+	Target     uint16
 	Ability    *Ability
 }
 
+// Executes the action and spends the budget of the acting piece. An ability aimed at an invalid target
+// does nothing and costs nothing, its result is recorded as invalid. Ending the turn is handled by State
 func (a *Action) Execute(state *State) {
 	if a.ActionType == MoveType {
+		state.Board.BoardArray[a.Index].spendMove()
 		state.Board.SwitchPieces(a.Index, a.Target)
 	} else if a.ActionType == AbilityType && a.Ability != nil {
-		a.Ability.Execute(state, a.Index, a.Target)
+		if !a.Ability.CanTarget(state, a.Index, a.Target) {
+			state.LastResult = AbilityResult{Caster: a.Index, Target: a.Target}
+			return
+		}
+		state.Board.BoardArray[a.Index].spendAction()
+		state.Board.BoardArray[a.Index].payForAbility(a.Ability)
+		state.Board.RefreshSquare(a.Index)
+		state.LastResult = a.Ability.Execute(state, a.Index, a.Target)
 	}
 }
 
+// Cooldown is in the caster's turns, 1 means it can be used again next turn. Cost is paid from Resource.
+// Range and MinRange are in steps, diagonals count as two. TargetGround allows empty squares
 type Ability struct {
 	Name           string
 	Range          uint8
+	MinRange       uint8
+	IgnoresLOS     bool
+	Cooldown       uint8
+	Cost           float64
 	TargetSelf     bool
 	TargetFriendly bool
 	TargetEnemy    bool
+	TargetGround   bool
+	Area           AreaShape
 	Components     []interface{}
 }
 
-func (a *Ability) Execute(state *State, index uint8, target uint8) {
+// Resolves the ability from the caster on index aimed at target. Every piece in the ability's area is
+// run through the component pipeline: targeting, hit roll, damage, heal and finally status application.
+func (a *Ability) Execute(state *State, index uint16, target uint16) AbilityResult {
+	result := AbilityResult{Caster: index, Target: target}
+
+	// Copied so components still see the caster if it dies from its own area effect
+	caster := state.Board.BoardArray[index]
+
+	if !a.CanTarget(state, index, target) {
+		return result
+	}
+	result.Valid = true
+
+	for _, square := range a.Area.AffectedSquares(&state.Board, index, target) {
+		targetPiece := &state.Board.BoardArray[square]
+		if !a.affects(state, &caster, targetPiece) {
+			continue
+		}
+		result.Effects = append(result.Effects, a.resolve(state, &caster, square))
+	}
+
+	return result
+}
+
+// Only pieces are affected. Without friendly fire an area only hits what the target flags allow, with it
+// every piece in the area
+func (a *Ability) affects(state *State, caster, target *Piece) bool {
+	if target.PieceType != PlayerPiece && target.PieceType != EnemyPiece {
+		return false
+	}
+	if a.Area.Type == SingleShape || !a.Area.FriendlyFire {
+		return a.ValidateTarget(state, caster, target)
+	}
+	return true
+}
+
+func (a *Ability) resolve(state *State, caster *Piece, target uint16) EffectResult {
+	targetPiece := &state.Board.BoardArray[target]
+	effect := EffectResult{Index: target, Hit: true}
+
+	hitChance := float32(1)
+	for _, component := range a.Components {
+		if c, ok := component.(HitChanceComponent); ok {
+			hitChance *= c.CalculateHitChance(caster, targetPiece)
+		}
+	}
+	if hitChance < 1 && state.Rand().Float32() >= hitChance {
+		effect.Hit = false
+		return effect
+	}
+
+	for _, component := range a.Components {
+		if c, ok := component.(PhysicalDamageComponent); ok {
+			effect.Damage += c.CalculateDamage(caster, targetPiece)
+		}
+	}
+	for _, component := range a.Components {
+		if c, ok := component.(HealComponent); ok {
+			effect.Healing += c.CalculateHeal(caster, targetPiece)
+		}
+	}
+
+	effect.Absorbed, effect.Damage, effect.Killed = state.DamagePiece(target, effect.Damage)
+	if effect.Killed {
+		return effect
+	}
+	effect.Healing = state.HealPiece(target, effect.Healing)
+
+	for _, component := range a.Components {
+		if c, ok := component.(StatusComponent); ok {
+			c.ApplyStatus(caster, targetPiece)
+		}
+	}
+	// Statuses can change max health
+	state.Board.RefreshSquare(target)
+
+	return effect
+}
+
+// Checks that the caster on index can aim the ability at target, see InRange and ValidateTarget
+func (a *Ability) CanTarget(state *State, index, target uint16) bool {
+	return a.InRange(&state.Board, index, target) &&
+		a.ValidateTarget(state, &state.Board.BoardArray[index], &state.Board.BoardArray[target])
+}
+
+// Checks that the target is between MinRange and Range steps away and, unless the ability ignores it, in LOS
+func (a *Ability) InRange(board *Board, index, target uint16) bool {
+	distance := board.Distance(index, target)
+	if distance < int(a.MinRange) || distance > int(a.Range) {
+		return false
+	}
+	return a.IgnoresLOS || board.CalculateLos(index, target)
+}
+
+// Checks the Target flags relative to the caster and lets every TargetingComponent veto the target
+func (a *Ability) ValidateTarget(state *State, caster, target *Piece) bool {
+	switch {
+	case caster.Index == target.Index:
+		if !a.TargetSelf {
+			return false
+		}
+	case target.PieceType == EmptyPiece || target.PieceType == PlayerAreaPiece:
+		if !a.TargetGround {
+			return false
+		}
+	case target.PieceType != PlayerPiece && target.PieceType != EnemyPiece:
+		return false
+	case caster.PieceType == target.PieceType:
+		if !a.TargetFriendly {
+			return false
+		}
+	default:
+		if !a.TargetEnemy {
+			return false
+		}
+	}
+
+	for _, component := range a.Components {
+		if c, ok := component.(TargetingComponent); ok && !c.ValidateTarget(state, caster, target) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Outcome of a single ability execution. Valid is false if the target was rejected and nothing happened
+type AbilityResult struct {
+	Caster  uint16
+	Target  uint16
+	Valid   bool
+	Effects []EffectResult
+}
+
+// What happened to a single piece affected by an ability
+type EffectResult struct {
+	Index    uint16
+	Hit      bool
+	Absorbed float64 // Taken by shields
+	Damage   float64
+	Healing  float64
+	Killed   bool
 }
 
 // Components galore below
-type PhysicalDamageComponent interface {
-	CalculateDamage() int
+type TargetingComponent interface {
+	ValidateTarget(state *State, caster, target *Piece) bool
 }
 
 type HitChanceComponent interface {
-	CalculateHitChance() float32
+	CalculateHitChance(caster, target *Piece) float32
+}
+
+type PhysicalDamageComponent interface {
+	CalculateDamage(caster, target *Piece) float64
+}
+
+type HealComponent interface {
+	CalculateHeal(caster, target *Piece) float64
+}
+
+type StatusComponent interface {
+	ApplyStatus(caster, target *Piece)
+}
+
+type FlatHitChance struct {
+	Chance float32
+}
+
+func (c FlatHitChance) CalculateHitChance(caster, target *Piece) float32 {
+	return c.Chance
+}
+
+type FlatDamage struct {
+	Amount float64
+}
+
+func (c FlatDamage) CalculateDamage(caster, target *Piece) float64 {
+	return c.Amount
+}
+
+type FlatHeal struct {
+	Amount float64
+}
+
+func (c FlatHeal) CalculateHeal(caster, target *Piece) float64 {
+	return c.Amount
 }
@@ -0,0 +1,187 @@
+package game
+
+import (
+	"slices"
+	"testing"
+)
+
+// A piece with health and 10 resource that blocks movement like heroes and enemies do
+func newTestPiece(name string, pieceType PieceType, health float64, abilities ...Ability) Piece {
+	return Piece{
+		Name:       name,
+		Abilities:  abilities,
+		PieceType:  pieceType,
+		BlocksMove: true,
+		MoveRange:  2,
+		Stats: StatStruct{
+			Health:   NewPool(HealthStat, health),
+			Resource: NewPool(ResourceStat, 10),
+		},
+	}
+}
+
+// A width x height board of empty squares with pieces on their squares, in combat with actor to move
+func newTestState(width, height int, pieces map[uint16]Piece, actor Actor) State {
+	boardArray := make([]Piece, width*height)
+	for i := range boardArray {
+		boardArray[i] = Piece{Name: "Empty", PieceType: EmptyPiece}
+	}
+	for index, piece := range pieces {
+		boardArray[index] = piece
+	}
+
+	state := State{CurrentActor: actor}
+	state.Board.InitBoard(width, height, boardArray)
+	state.StartCombat(1)
+	return state
+}
+
+func TestInvalidAbilityCostsNothing(t *testing.T) {
+	strike := Ability{Name: "Strike", Range: 1, Cost: 3, Cooldown: 2, TargetEnemy: true, Components: []interface{}{FlatDamage{Amount: 1}}}
+	state := newTestState(4, 4, map[uint16]Piece{
+		0: newTestPiece("Hero", PlayerPiece, 10, strike),
+		1: newTestPiece("Ally", PlayerPiece, 10),
+		3: newTestPiece("Far", EnemyPiece, 10),
+		4: newTestPiece("Near", EnemyPiece, 10),
+	}, PlayerActor)
+
+	ability := &state.Board.BoardArray[0].Abilities[0]
+	for _, target := range []uint16{1, 3, 5} {
+		state.ExecuteAction(Action{AbilityType, 0, target, ability})
+
+		caster := &state.Board.BoardArray[0]
+		if state.LastResult.Valid {
+			t.Errorf("strike on %d was valid", target)
+		}
+		if caster.ActionsLeft != DefaultActionsPerTurn || caster.Stats.Resource.Current != 10 || caster.CooldownLeft(0) != 0 {
+			t.Errorf("strike on %d charged %d actions, %v resource and %d cooldown", target,
+				DefaultActionsPerTurn-caster.ActionsLeft, 10-caster.Stats.Resource.Current, caster.CooldownLeft(0))
+		}
+	}
+
+	state.ExecuteAction(Action{AbilityType, 0, 4, ability})
+	caster := &state.Board.BoardArray[0]
+	if !state.LastResult.Valid || state.Board.BoardArray[4].Stats.Health.Current != 9 {
+		t.Fatalf("strike on the adjacent enemy did not hit: %+v", state.LastResult)
+	}
+	if caster.ActionsLeft != DefaultActionsPerTurn-1 || caster.Stats.Resource.Current != 7 || caster.CooldownLeft(0) != 2 {
+		t.Errorf("valid strike left %d actions, %v resource and %d cooldown", caster.ActionsLeft,
+			caster.Stats.Resource.Current, caster.CooldownLeft(0))
+	}
+}
+
+// Rejects targets with less health than the limit
+type minHealthTarget struct {
+	limit float64
+}
+
+func (c minHealthTarget) ValidateTarget(state *State, caster, target *Piece) bool {
+	return target.Stats.Health.Current >= c.limit
+}
+
+func TestValidateTarget(t *testing.T) {
+	tree := Piece{Name: "Tree", PieceType: TerrainPiece, BlocksLOS: true, BlocksMove: true}
+	state := newTestState(3, 3, map[uint16]Piece{
+		0: newTestPiece("Hero", PlayerPiece, 10),
+		1: newTestPiece("Ally", PlayerPiece, 3),
+		2: newTestPiece("Enemy", EnemyPiece, 10),
+		3: {Name: "PlayerArea", PieceType: PlayerAreaPiece},
+		4: tree,
+	}, PlayerActor)
+
+	type targetKind struct {
+		name  string
+		index uint16
+		// Which flag allows the target, nil if nothing does
+		allowedBy func(a Ability) bool
+	}
+	kinds := []targetKind{
+		{"self", 0, func(a Ability) bool { return a.TargetSelf }},
+		{"ally", 1, func(a Ability) bool { return a.TargetFriendly }},
+		{"enemy", 2, func(a Ability) bool { return a.TargetEnemy }},
+		{"player area", 3, func(a Ability) bool { return a.TargetGround }},
+		{"terrain", 4, nil},
+		{"empty", 5, func(a Ability) bool { return a.TargetGround }},
+	}
+
+	caster := &state.Board.BoardArray[0]
+	for flags := range 16 {
+		ability := Ability{
+			Name:           "Test",
+			TargetSelf:     flags&1 != 0,
+			TargetFriendly: flags&2 != 0,
+			TargetEnemy:    flags&4 != 0,
+			TargetGround:   flags&8 != 0,
+		}
+		for _, kind := range kinds {
+			want := kind.allowedBy != nil && kind.allowedBy(ability)
+			if got := ability.ValidateTarget(&state, caster, &state.Board.BoardArray[kind.index]); got != want {
+				t.Errorf("%+v on %s: %v, want %v", ability, kind.name, got, want)
+			}
+		}
+	}
+
+	// A targeting component can only veto what the flags allow
+	ability := Ability{Name: "Test", TargetFriendly: true, TargetEnemy: true, Components: []interface{}{minHealthTarget{limit: 5}}}
+	for index, want := range map[uint16]bool{1: false, 2: true, 5: false} {
+		if got := ability.ValidateTarget(&state, caster, &state.Board.BoardArray[index]); got != want {
+			t.Errorf("component on %d: %v, want %v", index, got, want)
+		}
+	}
+}
+
+func TestInRange(t *testing.T) {
+	// 5x5, the caster in the top left corner and a tree on square 2 in the first row
+	//   C . T . .
+	//   . . . . .
+	state := newTestState(5, 5, map[uint16]Piece{
+		0: newTestPiece("Hero", PlayerPiece, 10),
+		2: {Name: "Tree", PieceType: TerrainPiece, BlocksLOS: true, BlocksMove: true},
+	}, PlayerActor)
+	board := &state.Board
+
+	tests := []struct {
+		name    string
+		ability Ability
+		target  uint16
+		want    bool
+	}{
+		{"adjacent", Ability{Range: 1}, 1, true},
+		{"beyond range", Ability{Range: 1}, 6, false},
+		{"diagonal counts as two", Ability{Range: 2}, 6, true},
+		{"self at range 0", Ability{Range: 0}, 0, true},
+		{"below min range", Ability{Range: 3, MinRange: 2}, 1, false},
+		{"at min range", Ability{Range: 3, MinRange: 2}, 10, true},
+		{"at max range", Ability{Range: 3, MinRange: 2}, 15, true},
+		{"self below min range", Ability{Range: 3, MinRange: 1}, 0, false},
+		{"behind the tree", Ability{Range: 4}, 3, false},
+		{"behind the tree ignoring LOS", Ability{Range: 4, IgnoresLOS: true}, 3, true},
+		{"past the tree", Ability{Range: 4}, 8, true},
+	}
+	for _, test := range tests {
+		if got := test.ability.InRange(board, 0, test.target); got != test.want {
+			t.Errorf("%s: %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestValidAbilitiesOnGround(t *testing.T) {
+	// A ground targeted blast with a minimum range lists every square 2 to 3 steps away, except the
+	// tree and the square behind it
+	blast := Ability{Name: "Blast", Range: 3, MinRange: 2, TargetGround: true, TargetEnemy: true}
+	state := newTestState(5, 5, map[uint16]Piece{
+		0:  newTestPiece("Hero", PlayerPiece, 10, blast),
+		2:  {Name: "Tree", PieceType: TerrainPiece, BlocksLOS: true, BlocksMove: true},
+		11: newTestPiece("Enemy", EnemyPiece, 10),
+	}, PlayerActor)
+
+	var targets []uint16
+	for _, action := range state.Board.BoardArray[0].GetValidAbilities(&state) {
+		targets = append(targets, action.Target)
+	}
+
+	want := []uint16{6, 7, 10, 11, 15}
+	if !slices.Equal(targets, want) {
+		t.Errorf("targets %v, want %v", targets, want)
+	}
+}
@@ -0,0 +1,110 @@
+package game
+
+import (
+	"math"
+
+	"github.com/steuercarlsen/chessDungeonCrawler/internal/board_map"
+)
+
+type ShapeType uint8
+
+const (
+	SingleShape ShapeType = iota // Only the target square
+	RadiusShape                  // Every square within Size steps of the target, diagonals included
+	LineShape                    // The LOS line from the caster to the target, and the target
+	ConeShape                    // A 90 degree cone Size squares long from the caster towards the target
+	CrossShape                   // The target and Size squares in each straight direction from it
+	RingShape                    // The squares around the target, but not the target
+)
+
+// The area an ability affects. Without FriendlyFire only pieces allowed by the ability's target flags
+// are affected, with it every piece in the area is
+type AreaShape struct {
+	Type         ShapeType
+	Size         uint8
+	FriendlyFire bool
+}
+
+func chebyshev(dx, dy int) int {
+	return max(abs(dx), abs(dy))
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Lists the squares affected when aiming from caster at target, ignoring what is on them
+func (a AreaShape) AffectedSquares(board *Board, caster, target uint16) []uint16 {
+	switch a.Type {
+	case LineShape:
+		return lineSquares(board, caster, target)
+	case ConeShape:
+		return coneSquares(board, caster, target, int(a.Size))
+	}
+
+	targetX, targetY := board.squareXY(target)
+	squares := make([]uint16, 0, 16)
+
+	for i := range uint16(board.Size()) {
+		x, y := board.squareXY(i)
+		dx, dy := x-targetX, y-targetY
+		distance := chebyshev(dx, dy)
+
+		var affected bool
+		switch a.Type {
+		case SingleShape:
+			affected = distance == 0
+		case RadiusShape:
+			affected = distance <= int(a.Size)
+		case CrossShape:
+			affected = (dx == 0 || dy == 0) && distance <= int(a.Size)
+		case RingShape:
+			affected = distance == 1
+		}
+
+		if affected {
+			squares = append(squares, i)
+		}
+	}
+
+	return squares
+}
+
+func lineSquares(board *Board, caster, target uint16) []uint16 {
+	line := board.layout.LOSLine(caster, target)
+	// Neither the caster itself nor a neighbor has squares in between
+	if line[0] == board_map.SelfSquare || line[0] == board_map.NeighborSquare {
+		return []uint16{target}
+	}
+	return append(append(make([]uint16, 0, len(line)+1), line...), target)
+}
+
+func coneSquares(board *Board, caster, target uint16, size int) []uint16 {
+	casterX, casterY := board.squareXY(caster)
+	targetX, targetY := board.squareXY(target)
+	dirX, dirY := targetX-casterX, targetY-casterY
+	if dirX == 0 && dirY == 0 {
+		return nil
+	}
+	dirLength := math.Hypot(float64(dirX), float64(dirY))
+
+	squares := make([]uint16, 0, 16)
+	for i := range uint16(board.Size()) {
+		x, y := board.squareXY(i)
+		dx, dy := x-casterX, y-casterY
+		distance := chebyshev(dx, dy)
+		if distance == 0 || distance > size {
+			continue
+		}
+
+		// Within 45 degrees of the aimed direction, with a little slack for the exact diagonals
+		cos := float64(dx*dirX+dy*dirY) / (math.Hypot(float64(dx), float64(dy)) * dirLength)
+		if cos >= math.Sqrt2/2-1e-9 {
+			squares = append(squares, i)
+		}
+	}
+	return squares
+}
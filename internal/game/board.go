@@ -1,71 +1,168 @@
 package game
 
-import "github.com/steuercarlsen/chessDungeonCrawler/internal/board_map"
+import (
+	"fmt"
 
-type BitBoard uint64
+	"github.com/steuercarlsen/chessDungeonCrawler/internal/board_map"
+)
 
-func (b *BitBoard) SetPiece(index uint8) {
-	*b |= 1 << index
+// A bit per square of the largest supported board, so blocking checks stay a shift and a mask on any size
+type BitBoard [board_map.MaxSize / 64]uint64
+
+func (b *BitBoard) SetPiece(index uint16) {
+	b[index>>6] |= 1 << (index & 63)
 }
 
-func (b *BitBoard) ClearPiece(index uint8) {
-	*b &^= 1 << index
+func (b *BitBoard) ClearPiece(index uint16) {
+	b[index>>6] &^= 1 << (index & 63)
 }
 
-func (b *BitBoard) GetPiece(index uint8) bool {
-	return *b&(1<<index) != 0
+func (b *BitBoard) GetPiece(index uint16) bool {
+	return b[index>>6]&(1<<(index&63)) != 0
 }
 
+// BoardArray holds Width() * Height() squares, see board_map for how they are indexed
 type Board struct {
-	BoardArray         [64]Piece
+	BoardArray         []Piece
 	MoveBoard          BitBoard
 	LOSBoard           BitBoard
-	playerPieceIndexes []uint8
-	aiPieceIndexes     []uint8
+	layout             *board_map.Layout
+	moveCosts          []uint8 // Per square, nil while every square costs 1. Shared between clones
+	hash               uint64
+	squareKeys         []uint64 // pieceKey of every square, so UpdateSquare can take the old piece out of hash
+	playerPieceIndexes []uint16
+	aiPieceIndexes     []uint16
 }
 
 func (b *Board) Clone() Board {
-	returnBoardArray := [64]Piece{}
+	// Copying the whole slice at once and then only the slices inside the pieces is the same as Piece.Clone
+	// on every square, but a lot faster as clones are the bulk of the work in MCTS
+	returnBoardArray := append([]Piece(nil), b.BoardArray...)
 
-	for i, piece := range b.BoardArray {
-		returnBoardArray[i] = piece.Clone()
+	for i := range returnBoardArray {
+		piece := &returnBoardArray[i]
+		piece.StatusEffects = piece.cloneStatusEffects()
+		piece.Cooldowns = append([]uint8(nil), piece.Cooldowns...)
 	}
 
 	return Board{
 		BoardArray:         returnBoardArray,
 		MoveBoard:          b.MoveBoard,
 		LOSBoard:           b.LOSBoard,
-		playerPieceIndexes: b.playerPieceIndexes,
-		aiPieceIndexes:     b.aiPieceIndexes,
+		layout:             b.layout,
+		moveCosts:          b.moveCosts,
+		hash:               b.hash,
+		squareKeys:         append([]uint64(nil), b.squareKeys...),
+		playerPieceIndexes: append([]uint16(nil), b.playerPieceIndexes...),
+		aiPieceIndexes:     append([]uint16(nil), b.aiPieceIndexes...),
 	}
 }
 
-func (b *Board) InitBoard(boardArray [64]Piece) {
+// Sets up a width x height board from boardArray, which must hold width * height pieces row by row
+func (b *Board) InitBoard(width, height int, boardArray []Piece) {
+	b.layout = board_map.GetLayout(width, height)
+	if len(boardArray) != b.layout.Size {
+		panic(fmt.Sprintf("board of %dx%d needs %d squares, got %d", width, height, b.layout.Size, len(boardArray)))
+	}
 	b.BoardArray = boardArray
-	b.MoveBoard = 0
-	b.LOSBoard = 0
-	b.playerPieceIndexes = []uint8{}
-	b.aiPieceIndexes = []uint8{}
+	b.MoveBoard = BitBoard{}
+	b.LOSBoard = BitBoard{}
+	b.moveCosts = nil
+	b.hash = 0
+	b.squareKeys = make([]uint64, len(boardArray))
+	b.playerPieceIndexes = []uint16{}
+	b.aiPieceIndexes = []uint16{}
 	for i, piece := range b.BoardArray {
 		//$TODO: Send values to frontend VisualBoard
-		b.UpdateSquare(uint8(i), piece)
+		piece.Index = uint16(i)
+		b.UpdateSquare(uint16(i), piece)
 		if piece.PieceType == PlayerPiece {
-			b.playerPieceIndexes = append(b.playerPieceIndexes, uint8(i))
+			b.playerPieceIndexes = append(b.playerPieceIndexes, uint16(i))
 		} else if piece.PieceType == EnemyPiece {
-			b.aiPieceIndexes = append(b.aiPieceIndexes, uint8(i))
+			b.aiPieceIndexes = append(b.aiPieceIndexes, uint16(i))
 		}
 	}
 }
 
-func (b *Board) SwitchPieces(index1, index2 uint8) {
+func (b *Board) Width() int {
+	return b.layout.Width
+}
+
+func (b *Board) Height() int {
+	return b.layout.Height
+}
+
+func (b *Board) Size() int {
+	return b.layout.Size
+}
+
+func (b *Board) squareXY(index uint16) (int, int) {
+	return int(index) % b.layout.Width, int(index) / b.layout.Width
+}
+
+// Steps between two squares when moving orthogonally, which is how ranges are counted
+func (b *Board) Distance(index, target uint16) int {
+	x1, y1 := b.squareXY(index)
+	x2, y2 := b.squareXY(target)
+	return abs(x1-x2) + abs(y1-y2)
+}
+
+func (b *Board) SwitchPieces(index1, index2 uint16) {
 	temp1 := b.BoardArray[index1]
 	temp2 := b.BoardArray[index2]
+	temp1.Index = index2
+	temp2.Index = index1
 	b.UpdateSquare(index1, temp2)
 	b.UpdateSquare(index2, temp1)
+	swapIndexes(b.playerPieceIndexes, index1, index2)
+	swapIndexes(b.aiPieceIndexes, index1, index2)
+}
+
+func swapIndexes(indexes []uint16, index1, index2 uint16) {
+	for i, idx := range indexes {
+		if idx == index1 {
+			indexes[i] = index2
+		} else if idx == index2 {
+			indexes[i] = index1
+		}
+	}
+}
+
+// Replaces the piece on index with an empty square and drops it from the piece index lists
+func (b *Board) RemovePiece(index uint16) {
+	b.UpdateSquare(index, Piece{Name: "Empty", Index: index, PieceType: EmptyPiece})
+	b.playerPieceIndexes = removeIndex(b.playerPieceIndexes, index)
+	b.aiPieceIndexes = removeIndex(b.aiPieceIndexes, index)
 }
 
-func (b *Board) UpdateSquare(index uint8, piece Piece) {
+// Puts the piece on index, replacing whatever was there, and keeps the piece index lists in step. Unlike
+// InitBoard the rest of the board, its move costs included, is left as it is
+func (b *Board) PlacePiece(index uint16, piece Piece) {
+	piece.Index = index
+	b.playerPieceIndexes = removeIndex(b.playerPieceIndexes, index)
+	b.aiPieceIndexes = removeIndex(b.aiPieceIndexes, index)
+	b.UpdateSquare(index, piece)
+	if piece.PieceType == PlayerPiece {
+		b.playerPieceIndexes = append(b.playerPieceIndexes, index)
+	} else if piece.PieceType == EnemyPiece {
+		b.aiPieceIndexes = append(b.aiPieceIndexes, index)
+	}
+}
+
+func removeIndex(indexes []uint16, index uint16) []uint16 {
+	for i, idx := range indexes {
+		if idx == index {
+			return append(indexes[:i], indexes[i+1:]...)
+		}
+	}
+	return indexes
+}
+
+func (b *Board) UpdateSquare(index uint16, piece Piece) {
 	b.BoardArray[index] = piece
+	key := pieceKey(&piece, index)
+	b.hash ^= b.squareKeys[index] ^ key
+	b.squareKeys[index] = key
 	if piece.BlocksMove {
 		b.MoveBoard.SetPiece(index)
 	} else {
@@ -78,10 +175,10 @@ func (b *Board) UpdateSquare(index uint8, piece Piece) {
 	}
 }
 
-func (b *Board) CalculateLos(index, target uint8) bool {
-	losLine := board_map.LOSLineMap[index][target]
+func (b *Board) CalculateLos(index, target uint16) bool {
+	losLine := b.layout.LOSLine(index, target)
 
-	if losLine[0] == 254 || losLine[0] == 255 {
+	if losLine[0] == board_map.NeighborSquare || losLine[0] == board_map.SelfSquare {
 		return true
 	}
 
@@ -94,40 +191,85 @@ func (b *Board) CalculateLos(index, target uint8) bool {
 	return true
 }
 
-func (b *Board) CalculateRange(index uint8, rangeValue uint8, checkLos bool) []uint8 {
-	ranges := make([]uint8, 0, 64)
-	calculatedSquares := make([]uint8, 0, 64)
-	queueIndexes := make([]uint8, 0, 64)
-	queueRanges := make([]uint8, 0, 64)
+// Every square at most rangeValue steps from index, including index itself, ignoring anything in the way
+func (b *Board) SquaresWithin(index uint16, rangeValue uint8) []uint16 {
+	squares := make([]uint16, 0, b.layout.Size)
+	for i := range uint16(b.layout.Size) {
+		if b.Distance(index, i) <= int(rangeValue) {
+			squares = append(squares, i)
+		}
+	}
+	return squares
+}
+
+// What it costs to move onto the square, at least 1
+func (b *Board) MoveCost(index uint16) uint8 {
+	if b.moveCosts == nil {
+		return 1
+	}
+	return b.moveCosts[index]
+}
 
-	queueStart := uint8(0)
-	queueEnd := uint8(0)
+// Sets the cost of moving onto the square, costs below 1 are raised to 1. The costs are copied before
+// changing them as clones share them
+func (b *Board) SetMoveCost(index uint16, cost uint8) {
+	costs := make([]uint8, b.layout.Size)
+	if b.moveCosts == nil {
+		for i := range costs {
+			costs[i] = 1
+		}
+	} else {
+		copy(costs, b.moveCosts)
+	}
+	costs[index] = max(cost, 1)
+	b.moveCosts = costs
+}
 
-	queueIndexes[0] = index
-	queueRanges[0] = rangeValue + 1
+// Every square a piece on index can move to with a budget of rangeValue, where entering a square costs its
+// MoveCost and squares on the MoveBoard can't be entered or passed. Index itself is not included
+func (b *Board) CalculateRange(index uint16, rangeValue uint8) []uint16 {
+	ranges := make([]uint16, 0, b.layout.Size)
+	// Cheapest cost found to every square plus one, so 0 means not reached yet
+	costs := [board_map.MaxSize]uint16{}
+	frontier := [board_map.MaxSize]uint16{}
+	settled := BitBoard{}
+	limit := uint16(rangeValue) + 1
 
-	for queueStart < queueEnd {
-		currentIndex := queueIndexes[queueStart]
-		currentRange := queueRanges[queueStart]
-		queueStart++
+	costs[index] = 1
+	frontier[0] = index
+	frontierLength := 1
 
-		if currentRange < 1 {
-			continue
+	// Dijkstra. Boards and budgets are small, so finding the cheapest square with a scan beats a heap
+	for frontierLength > 0 {
+		cheapest := 0
+		for i := 1; i < frontierLength; i++ {
+			if costs[frontier[i]] < costs[frontier[cheapest]] {
+				cheapest = i
+			}
 		}
+		current := frontier[cheapest]
+		frontierLength--
+		frontier[cheapest] = frontier[frontierLength]
 
-		for _, neighbor := range board_map.NeighborMap[currentIndex] {
-
-			newRange := currentRange - 1
+		settled.SetPiece(current)
+		if current != index {
+			ranges = append(ranges, current)
+		}
 
-			if newRange > calculatedSquares[neighbor] {
-				if (checkLos && b.CalculateLos(index, neighbor)) ||
-					(!checkLos && !b.MoveBoard.GetPiece(neighbor)) {
-					ranges = append(ranges, neighbor)
-					calculatedSquares[neighbor] = newRange
-					queueIndexes[queueEnd] = neighbor
-					queueRanges[queueEnd] = newRange
-					queueEnd++
-				}
+		for _, neighbor := range b.layout.Neighbors[current] {
+			if settled.GetPiece(neighbor) || b.MoveBoard.GetPiece(neighbor) {
+				continue
+			}
+			cost := costs[current] + uint16(b.MoveCost(neighbor))
+			if cost > limit {
+				continue
+			}
+			if costs[neighbor] == 0 {
+				frontier[frontierLength] = neighbor
+				frontierLength++
+				costs[neighbor] = cost
+			} else if cost < costs[neighbor] {
+				costs[neighbor] = cost
 			}
 		}
 	}
@@ -0,0 +1,34 @@
+package game
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPlacePieceKeepsBoard(t *testing.T) {
+	state := newTestState(3, 3, map[uint16]Piece{
+		0: {Name: "PlayerArea", PieceType: PlayerAreaPiece},
+		8: newTestPiece("Enemy", EnemyPiece, 10),
+	}, PlayerActor)
+	board := &state.Board
+	board.SetMoveCost(4, 3)
+
+	board.PlacePiece(0, newTestPiece("Hero", PlayerPiece, 10))
+	board.PlacePiece(8, newTestPiece("Hero", PlayerPiece, 10))
+
+	if board.MoveCost(4) != 3 {
+		t.Errorf("move cost of the mud is %d after placing pieces, want 3", board.MoveCost(4))
+	}
+	if !slices.Equal(board.playerPieceIndexes, []uint16{0, 8}) || len(board.aiPieceIndexes) != 0 {
+		t.Errorf("player pieces on %v, AI pieces on %v", board.playerPieceIndexes, board.aiPieceIndexes)
+	}
+	if board.BoardArray[8].Index != 8 || !board.MoveBoard.GetPiece(0) {
+		t.Error("the placed pieces don't know their square or don't block it")
+	}
+
+	rebuilt := Board{}
+	rebuilt.InitBoard(3, 3, append([]Piece(nil), board.BoardArray...))
+	if board.Hash() != rebuilt.Hash() {
+		t.Error("placing pieces hashes differently from setting the board up with them")
+	}
+}
@@ -14,6 +14,53 @@ const (
 	PostCombat
 )
 
+type TurnPhase uint8
+
+const (
+	TurnStartPhase  TurnPhase = iota
+	TurnActionPhase           // Waiting for the current actor to execute an action
+	TurnEndPhase
+)
+
+// Hooks run when a phase is entered, e.g. damage over time at turn start or status expiry at turn end
+type TurnHook func(s *State)
+
+var turnHooks = map[TurnPhase][]TurnHook{}
+
+// Hooks are global and should be registered from init functions, they run in registration order
+func RegisterTurnHook(phase TurnPhase, hook TurnHook) {
+	turnHooks[phase] = append(turnHooks[phase], hook)
+}
+
+func init() {
+	RegisterTurnHook(TurnStartPhase, resetBudgets)
+	RegisterTurnHook(TurnStartPhase, tickCooldowns)
+}
+
+// Refills the budget of every piece belonging to the actor whose turn is starting
+func resetBudgets(s *State) {
+	for _, idx := range s.pieceIndexes(s.CurrentActor) {
+		s.Board.BoardArray[idx].ResetBudget()
+		s.Board.RefreshSquare(idx)
+	}
+}
+
+func tickCooldowns(s *State) {
+	for _, idx := range s.pieceIndexes(s.CurrentActor) {
+		s.Board.BoardArray[idx].tickCooldowns()
+		s.Board.RefreshSquare(idx)
+	}
+}
+
+// Death hooks run right after a piece died and was removed from the board
+type DeathHook func(s *State, piece Piece)
+
+var deathHooks []DeathHook
+
+func RegisterDeathHook(hook DeathHook) {
+	deathHooks = append(deathHooks, hook)
+}
+
 type Actor uint8
 
 const (
@@ -22,65 +69,161 @@ const (
 )
 
 type State struct {
-	GameState       GameState
-	CurrentActor    Actor
-	currentTurnType uint8
-	turn            uint16
-	LastAction      Action
-	Board           Board
+	GameState    GameState
+	CurrentActor Actor
+	phase        TurnPhase
+	turn         uint16
+	LastAction   Action
+	LastResult   AbilityResult
+	Board        Board
+	Seed         int64
+	SurviveTurns uint16 // If set the player also wins after surviving this many rounds
+	rng          RNG
 }
 
 func (s *State) Clone() State {
 	return State{
-		GameState:       s.GameState,
-		CurrentActor:    s.CurrentActor,
-		currentTurnType: s.currentTurnType,
-		turn:            s.turn,
-		LastAction:      s.LastAction,
-		Board:           s.Board.Clone(),
+		GameState:    s.GameState,
+		CurrentActor: s.CurrentActor,
+		phase:        s.phase,
+		turn:         s.turn,
+		LastAction:   s.LastAction,
+		LastResult:   s.LastResult,
+		Board:        s.Board.Clone(),
+		Seed:         s.Seed,
+		SurviveTurns: s.SurviveTurns,
+		rng:          s.rng,
 	}
 }
 
-func (s *State) AdvanceTurn() {
-	if s.GameState == InCombat {
-		s.currentTurnType++
+// All randomness in combat has to come from here, otherwise replays and MCTS runs are not reproducible
+func (s *State) Rand() *RNG {
+	return &s.rng
+}
+
+// Replaces the state's random stream with one drawn from rng. Searches reseed their clones of the real
+// state, which would otherwise hand them the outcome of every roll the combat is going to make
+func (s *State) reseed(rng *RNG) {
+	s.rng = NewRNG(int64(rng.Uint64()))
+}
 
-		switch s.currentTurnType {
-		case 1:
-			s.turn++
+// Enters combat and advances to the first action phase. The seed is recorded so the combat can be replayed
+func (s *State) StartCombat(seed int64) {
+	s.Seed = seed
+	s.rng = NewRNG(seed)
+	s.GameState = InCombat
+	s.phase = TurnStartPhase
+	s.AdvanceTurn()
+}
+
+// Plays the actions from the start of a combat, setup being the state before StartCombat. The same setup,
+// seed and actions always give the same state
+func Replay(setup State, seed int64, actions []Action) State {
+	state := setup.Clone()
+	state.StartCombat(seed)
+	for _, action := range actions {
+		state.ExecuteAction(action)
+	}
+	return state
+}
+
+func (s *State) Phase() TurnPhase {
+	return s.phase
+}
+
+func (s *State) Turn() uint16 {
+	return s.turn
+}
+
+func (s *State) WaitingForInput() bool {
+	return s.GameState == InCombat && s.phase == TurnActionPhase
+}
+
+// Steps through the phases until the current actor has to act again or the combat is over
+func (s *State) AdvanceTurn() {
+	for s.GameState == InCombat {
+		switch s.phase {
+		case TurnStartPhase:
 			s.TurnStart()
-		case 2:
-			s.TurnAction()
-		case 3:
-			s.currentTurnType = 0
-			playerWin, aiWin := s.IsTerminal()
-			if playerWin || aiWin {
-				s.GameEnd()
+			if s.checkGameEnd() {
+				return
 			}
+			s.phase = TurnActionPhase
+			s.TurnAction()
+			return
+		case TurnActionPhase:
+			s.phase = TurnEndPhase
+		case TurnEndPhase:
 			s.TurnEnd()
+			if s.checkGameEnd() {
+				return
+			}
+			s.phase = TurnStartPhase
 		}
 	}
 }
 
 func (s *State) TurnStart() {
-	s.AdvanceTurn()
+	s.turn++
+	s.runTurnHooks(TurnStartPhase)
 }
 
 func (s *State) TurnAction() {
-	s.AdvanceTurn()
+	s.runTurnHooks(TurnActionPhase)
 }
 
 func (s *State) TurnEnd() {
+	s.runTurnHooks(TurnEndPhase)
 	if s.CurrentActor == PlayerActor {
 		s.CurrentActor = AIActor
 	} else {
 		s.CurrentActor = PlayerActor
 	}
-	s.AdvanceTurn()
+}
+
+func (s *State) runTurnHooks(phase TurnPhase) {
+	for _, hook := range turnHooks[phase] {
+		hook(s)
+	}
+}
+
+func (s *State) checkGameEnd() bool {
+	aiWin, playerWin := s.IsTerminal()
+	if aiWin || playerWin {
+		s.GameEnd()
+		return true
+	}
+	return false
+}
+
+// Damages the piece on index, shields first. A piece whose health reaches zero is removed from the board
+// and the death hooks are run
+func (s *State) DamagePiece(index uint16, amount float64) (absorbed, dealt float64, killed bool) {
+	piece := &s.Board.BoardArray[index]
+	absorbed, dealt, emptied := piece.Stats.Health.Damage(amount)
+	piece.absorbShield(absorbed)
+	if !emptied || !piece.IsDead() {
+		s.Board.RefreshSquare(index)
+		return absorbed, dealt, false
+	}
+
+	dead := *piece
+	s.Board.RemovePiece(index)
+	for _, hook := range deathHooks {
+		hook(s, dead)
+	}
+	return absorbed, dealt, true
+}
+
+// Heals the piece on index up to its max health and returns the amount healed
+func (s *State) HealPiece(index uint16, amount float64) float64 {
+	healed := s.Board.BoardArray[index].Stats.Health.Heal(amount)
+	s.Board.RefreshSquare(index)
+	return healed
 }
 
 func (s *State) GameEnd() {
-	// TODO
+	s.GameState = PostCombat
 }
 
 func (s *State) IsTerminal() (bool, bool) {
@@ -90,41 +233,70 @@ func (s *State) IsTerminal() (bool, bool) {
 // Checks if any pieces are left - remember to remove pieces from array when they die
 func (s *State) CheckWinCondition(actor Actor) bool {
 	if actor == PlayerActor {
-		return len(s.Board.aiPieceIndexes) == 0
+		// Both actors get a turn per round
+		survived := s.SurviveTurns > 0 && s.turn > 2*s.SurviveTurns
+		return len(s.Board.aiPieceIndexes) == 0 || survived
 	} else {
 		return len(s.Board.playerPieceIndexes) == 0
 	}
 }
 
-func (s *State) GetPossibleActions() []Action {
-	allActions := make([]Action, 0, 64)
+func (s *State) pieceIndexes(actor Actor) []uint16 {
+	if actor == PlayerActor {
+		return s.Board.playerPieceIndexes
+	}
+	return s.Board.aiPieceIndexes
+}
 
-	var pieceIndexes []uint8
-	if s.CurrentActor == PlayerActor {
-		pieceIndexes = s.Board.playerPieceIndexes
-	} else {
-		pieceIndexes = s.Board.aiPieceIndexes
+// Checks if any piece of the current actor has budget left
+func (s *State) HasActionsLeft() bool {
+	for _, idx := range s.pieceIndexes(s.CurrentActor) {
+		if s.Board.BoardArray[idx].CanAct() {
+			return true
+		}
 	}
+	return false
+}
+
+// Lists the moves and abilities every piece of the current actor can still afford, and ending the turn
+func (s *State) GetPossibleActions() []Action {
+	allActions := make([]Action, 0, 64)
 
-	for _, idx := range pieceIndexes {
+	for _, idx := range s.pieceIndexes(s.CurrentActor) {
 		piece := s.Board.BoardArray[idx]
 
-		moves := piece.GetValidMoves(s.Board)
-		allActions = append(allActions, moves...)
+		if piece.CanMove() {
+			moves := piece.GetValidMoves(s.Board)
+			allActions = append(allActions, moves...)
+		}
 
-		if len(piece.Abilities) > 0 {
-			abilities := piece.GetValidAbilities(s.Board)
+		if piece.CanUseAbilities() {
+			abilities := piece.GetValidAbilities(s)
 			allActions = append(allActions, abilities...)
 		}
 	}
 
+	allActions = append(allActions, EndTurnAction)
+
 	return allActions
 }
 
-func (s *State) ExecuteAction(action Action) {
+// Executes the action for the current actor. The turn only advances when the actor ends it, wins,
+// or has no budget left. Returns false without doing anything if the state isn't waiting for input
+func (s *State) ExecuteAction(action Action) bool {
+	if !s.WaitingForInput() {
+		return false
+	}
 	action.Execute(s)
 	s.LastAction = action
-	s.AdvanceTurn()
+
+	if s.checkGameEnd() {
+		return true
+	}
+	if action.ActionType == EndTurnType || !s.HasActionsLeft() {
+		s.AdvanceTurn()
+	}
+	return true
 }
 
 func (s *State) GetLastAction() Action {
@@ -1,13 +1,23 @@
 package game
 
 type Enemy struct {
-	Name string
-	//TODO - Implement
+	Name      string
+	Stats     StatStruct
+	MoveRange uint8
+	Abilities []Ability
 }
 
-// Keep all enemies in a map for lookup when initiating an encounter
-var Enemies = map[string]*Enemy{
-	"Enemy1": {
-		Name: "Enemy1",
-	},
-}
\ No newline at end of file
+// Keep all enemies in a map for lookup when initiating an encounter. Filled from game_data's enemy file
+var Enemies = map[string]*Enemy{}
+
+// Creates a fresh piece for the enemy, the abilities are shared between all pieces of the same enemy
+func (e *Enemy) NewPiece() Piece {
+	return Piece{
+		Name:       e.Name,
+		Abilities:  e.Abilities,
+		PieceType:  EnemyPiece,
+		BlocksMove: true,
+		MoveRange:  e.MoveRange,
+		Stats:      e.Stats.clone(),
+	}
+}
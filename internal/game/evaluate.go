@@ -0,0 +1,112 @@
+package game
+
+import "math"
+
+// Scores a state that isn't terminal yet, from the AI's perspective in [0, 1] like a rollout result
+type Evaluator interface {
+	Evaluate(state *State) float64
+}
+
+// How much each term of HeuristicEvaluator counts. Only the ratios matter, all zero scores every state 0.5
+type EvaluationWeights struct {
+	Health   float64 `json:"health"`
+	Pieces   float64 `json:"pieces"`
+	Threat   float64 `json:"threat"`
+	Position float64 `json:"position"`
+}
+
+var DefaultWeights = EvaluationWeights{Health: 4, Pieces: 3, Threat: 2, Position: 1}
+
+// Combines terms that each run from -1 (player ahead) to 1 (AI ahead):
+//   - Health: fraction of max health left on the AI side minus that on the player side
+//   - Pieces: difference in pieces over the total
+//   - Threat: fraction of player pieces the AI can hit right now minus the other way around
+//   - Position: how much closer to the centre of the board the AI pieces are on average
+type HeuristicEvaluator struct {
+	Weights EvaluationWeights
+}
+
+func (e HeuristicEvaluator) Evaluate(state *State) float64 {
+	w := e.Weights
+	total := math.Abs(w.Health) + math.Abs(w.Pieces) + math.Abs(w.Threat) + math.Abs(w.Position)
+	if total == 0 {
+		return 0.5
+	}
+
+	ai := state.pieceIndexes(AIActor)
+	player := state.pieceIndexes(PlayerActor)
+
+	score := 0.0
+	if w.Health != 0 {
+		score += w.Health * (healthFraction(&state.Board, ai) - healthFraction(&state.Board, player))
+	}
+	if w.Pieces != 0 && len(ai)+len(player) > 0 {
+		score += w.Pieces * float64(len(ai)-len(player)) / float64(len(ai)+len(player))
+	}
+	if w.Threat != 0 {
+		score += w.Threat * (threatened(state, ai, player) - threatened(state, player, ai))
+	}
+	if w.Position != 0 {
+		score += w.Position * (centreDistance(&state.Board, player) - centreDistance(&state.Board, ai))
+	}
+
+	return (score/total + 1) / 2
+}
+
+// Health left over max health of all pieces together, 0 without pieces
+func healthFraction(board *Board, indexes []uint16) float64 {
+	var current, maximum float64
+	for _, index := range indexes {
+		current += board.BoardArray[index].Stats.Health.Current
+		maximum += board.BoardArray[index].Stats.Health.Max.Total
+	}
+	if maximum == 0 {
+		return 0
+	}
+	return current / maximum
+}
+
+// Fraction of targets at least one attacker can hit with an ability it can afford, ignoring action budgets
+func threatened(state *State, attackers, targets []uint16) float64 {
+	if len(targets) == 0 {
+		return 0
+	}
+
+	count := 0
+	for _, targetIndex := range targets {
+		target := &state.Board.BoardArray[targetIndex]
+	attackerLoop:
+		for _, attackerIndex := range attackers {
+			attacker := &state.Board.BoardArray[attackerIndex]
+			for slot := range attacker.Abilities {
+				ability := &attacker.Abilities[slot]
+				if attacker.CanAfford(slot) && ability.InRange(&state.Board, attackerIndex, targetIndex) &&
+					ability.ValidateTarget(state, attacker, target) {
+					count++
+					break attackerLoop
+				}
+			}
+		}
+	}
+	return float64(count) / float64(len(targets))
+}
+
+// Average distance of the pieces to the centre of the board, 0 on the centre and 1 in the corners
+func centreDistance(board *Board, indexes []uint16) float64 {
+	if len(indexes) == 0 {
+		return 0
+	}
+
+	centreX, centreY := float64(board.Width()-1)/2, float64(board.Height()-1)/2
+	maxDistance := centreX + centreY
+	if maxDistance == 0 {
+		return 0
+	}
+
+	total := 0.0
+	for _, index := range indexes {
+		x, y := board.squareXY(index)
+		total += math.Abs(float64(x)-centreX) + math.Abs(float64(y)-centreY)
+	}
+	return total / float64(len(indexes)) / maxDistance
+}
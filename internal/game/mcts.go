@@ -2,33 +2,76 @@ package game
 
 import (
 	"math"
-	"math/rand"
+	"sync"
+	"time"
 )
 
 const ExplorationConstant = 1.41421356237
 
+// Wins are counted from the perspective of actor, the one who chose the action leading to the node, so every
+// node scores its own side's choice. The statistics are shared with other nodes reaching the same position,
+// see TranspositionTable
 type TreeNode struct {
-	State          State
-	parent         *TreeNode
-	children       []*TreeNode
-	wins           uint16
-	visits         uint16
-	turns          uint16
+	State    State
+	parent   *TreeNode
+	children []*TreeNode
+	actor    Actor
+	*nodeStats
 	untriedActions []Action
 }
 
+// Results are in [0, 1], hence float wins
+type nodeStats struct {
+	wins   float64
+	visits uint64
+	turns  uint64
+}
+
+// Shares statistics between nodes that reach the same position through different move orders. Positions are
+// told apart by State.Hash, and the actor whose choice the node scores
+type TranspositionTable map[transpositionKey]*nodeStats
+
+type transpositionKey struct {
+	hash  uint64
+	actor Actor
+}
+
+// Returns the statistics of the position, creating them on first use. A nil table shares nothing
+func (t TranspositionTable) lookup(state *State, actor Actor) *nodeStats {
+	if t == nil {
+		return &nodeStats{}
+	}
+	key := transpositionKey{hash: state.Hash(), actor: actor}
+	stats, exists := t[key]
+	if !exists {
+		stats = &nodeStats{}
+		t[key] = stats
+	}
+	return stats
+}
+
 func (n *TreeNode) Init() *TreeNode {
+	if n.nodeStats == nil {
+		n.nodeStats = &nodeStats{}
+	}
 	n.untriedActions = n.State.GetPossibleActions()
 	return n
 }
 
-func (n *TreeNode) SelectCild() *TreeNode {
-	var bestUCT float64 = -1
+// UCB1: picks the child maximising its win rate + C * sqrt(ln(parent visits) / child visits). Unvisited
+// children are picked first
+func (n *TreeNode) SelectChild() *TreeNode {
+	bestUCT := math.Inf(-1)
 	var bestChild *TreeNode
+	logVisits := math.Log(float64(n.visits))
 
 	for _, child := range n.children {
-		exploitation := float64(child.wins) / float64(child.visits)
-		exploration := ExplorationConstant * (math.Log(float64(child.visits)) / float64(n.visits))
+		if child.visits == 0 {
+			return child
+		}
+
+		exploitation := child.wins / float64(child.visits)
+		exploration := ExplorationConstant * math.Sqrt(logVisits/float64(child.visits))
 		uct := exploitation + exploration
 
 		if uct > bestUCT {
@@ -40,20 +83,26 @@ func (n *TreeNode) SelectCild() *TreeNode {
 	return bestChild
 }
 
-func (n *TreeNode) expand() *TreeNode {
+func (n *TreeNode) expand(rng *RNG, table TranspositionTable) *TreeNode {
 	if len(n.untriedActions) < 1 {
 		return nil
 	}
 
-	actionIndex := rand.Intn(len(n.untriedActions))
+	actionIndex := rng.Intn(len(n.untriedActions))
 	action := n.untriedActions[actionIndex]
+	n.untriedActions[actionIndex] = n.untriedActions[len(n.untriedActions)-1]
+	n.untriedActions = n.untriedActions[:len(n.untriedActions)-1]
 
+	// Every expansion rolls its own chance outcomes, like a rollout does
 	nextState := n.State.Clone()
+	nextState.reseed(rng)
 	nextState.ExecuteAction(action)
 
 	childNode := &TreeNode{
-		State:  nextState,
-		parent: n,
+		State:     nextState,
+		parent:    n,
+		actor:     n.State.CurrentActor,
+		nodeStats: n.childStats(table, &nextState, n.State.CurrentActor),
 	}
 
 	n.children = append(n.children, childNode)
@@ -61,11 +110,28 @@ func (n *TreeNode) expand() *TreeNode {
 	return childNode.Init()
 }
 
-func (n *TreeNode) simulate(maxDepth uint16) (float32, uint16) {
-	state := n.State
+// Looks up the statistics of a new child. Should the position already be on the path to the root, the child
+// gets its own, as backpropagation would otherwise count every visit through it twice
+func (n *TreeNode) childStats(table TranspositionTable, state *State, actor Actor) *nodeStats {
+	stats := table.lookup(state, actor)
+	for ancestor := n; ancestor != nil; ancestor = ancestor.parent {
+		if ancestor.nodeStats == stats {
+			return &nodeStats{}
+		}
+	}
+	return stats
+}
+
+// Plays actions chosen by policy from the node until the game ends or maxDepth actions are played, where
+// evaluator scores the state reached. The result is from the AI's perspective: 1 for a win, 0 for a loss
+func (n *TreeNode) simulate(rng *RNG, maxDepth uint16, policy RolloutPolicy, evaluator Evaluator) (float64, uint16) {
+	// Reseed so rollouts from the same node don't all share the chance outcomes of the node's state
+	state := n.State.Clone()
+	state.reseed(rng)
 	depth := uint16(0)
 
-	for depth < maxDepth {
+	for {
+		// Checked once more after the last action, a game decided by it is a win or a loss, not an estimate
 		aiWin, playerWin := state.IsTerminal()
 
 		switch {
@@ -73,25 +139,30 @@ func (n *TreeNode) simulate(maxDepth uint16) (float32, uint16) {
 			return 1, depth
 		case playerWin:
 			return 0, depth
+		case depth >= maxDepth:
+			return evaluator.Evaluate(&state), depth
 		}
 
 		actions := state.GetPossibleActions()
-		action := actions[rand.Intn(len(actions))]
+		action := policy.ChooseAction(&state, actions, rng)
 
 		state.ExecuteAction(action)
 		depth++
 	}
-
-	return 0, depth
 }
 
-func (n *TreeNode) Backpropagate(result float32, depth uint16) {
+// Adds an AI perspective result to the node and its ancestors, flipped for nodes chosen by the player
+func (n *TreeNode) Backpropagate(result float64, depth uint16) {
 	current := n
 
 	for current != nil {
 		current.visits++
-		current.wins += uint16(result)
-		current.turns += depth
+		if current.actor == AIActor {
+			current.wins += result
+		} else {
+			current.wins += 1 - result
+		}
+		current.turns += uint64(depth)
 		current = current.parent
 	}
 }
@@ -104,15 +175,99 @@ func (n *TreeNode) IsTerminal() (bool, bool) {
 	return n.State.IsTerminal()
 }
 
+// Follows the children matching actions, nil if one of them was never expanded
+func (n *TreeNode) follow(actions []Action) *TreeNode {
+	node := n
+	for _, action := range actions {
+		key := keyOf(action)
+		var next *TreeNode
+		for _, child := range node.children {
+			if keyOf(child.State.LastAction) == key {
+				next = child
+				break
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		node = next
+	}
+	return node
+}
+
+// Makes n the root of its tree for state, which hashes like n's own state but can still differ, like in
+// health within a bucket. The untried actions are rebuilt from state and children whose action isn't
+// legal in it any more are dropped. Without a parent nothing refers to the old root and the pruned
+// branches any more
+func (n *TreeNode) reroot(state State) {
+	n.parent = nil
+	n.State = state.Clone()
+
+	possible := n.State.GetPossibleActions()
+	legal := make(map[actionKey]bool, len(possible))
+	for _, action := range possible {
+		legal[keyOf(action)] = true
+	}
+
+	expanded := make(map[actionKey]bool, len(n.children))
+	children := n.children[:0]
+	for _, child := range n.children {
+		key := keyOf(child.State.LastAction)
+		if legal[key] {
+			children = append(children, child)
+			expanded[key] = true
+		}
+	}
+	clear(n.children[len(children):])
+	n.children = children
+
+	n.untriedActions = make([]Action, 0, len(possible)-len(children))
+	for _, action := range possible {
+		if !expanded[keyOf(action)] {
+			n.untriedActions = append(n.untriedActions, action)
+		}
+	}
+}
+
+// Adds the statistics of every node below n, so the table holds nothing of pruned branches. A position that
+// kept its own statistics further down, see childStats, doesn't replace the entry found first
+func (t TranspositionTable) collect(n *TreeNode) {
+	for _, child := range n.children {
+		key := transpositionKey{hash: child.State.Hash(), actor: child.actor}
+		if _, exists := t[key]; !exists {
+			t[key] = child.nodeStats
+		}
+		t.collect(child)
+	}
+}
+
+// Collects the statistics of every expanded root action, the action leading to a child is its LastAction.
+// Wins are from the perspective of the actor to move at the node
+func (n *TreeNode) ActionStats() []ActionStats {
+	stats := make([]ActionStats, 0, len(n.children))
+	for _, child := range n.children {
+		stats = append(stats, ActionStats{
+			Action: child.State.LastAction,
+			Wins:   child.wins,
+			Visits: child.visits,
+			Turns:  child.turns,
+		})
+	}
+	return stats
+}
+
 type ActionStats struct {
 	Action Action
-	Wins   uint16
-	Visits uint32
-	Turns  uint16
+	Wins   float64
+	Visits uint64
+	Turns  uint64
 }
 
+// Iterations only counts the iterations of this search, CarriedVisits the root visits kept from earlier
+// searches through Advance
 type SearchMetadata struct {
-	Iterations         uint32
+	Iterations         uint64
+	CarriedVisits      uint64
 	BestScore          float64
 	BestActionAvgTurns float64
 }
@@ -121,57 +276,252 @@ type MCTS struct {
 	initialBoard  Board
 	initialActor  Actor
 	initialState  State
-	timeLimit     uint16
-	iterationGoal uint16
+	timeLimit     uint16 // Milliseconds, 0 means no limit
+	iterationGoal uint16 // 0 means no limit, applies to each worker
 	maxDepth      uint16
+	workers       int
+	seed          int64
+	policy        RolloutPolicy
+	evaluator     Evaluator
+	trees         []*searchTree // Per worker, kept between searches. nil entries start from initialState
 }
 
-func (m *MCTS) Search() *Action {
-	var results [][]ActionStats
-	// Passs to js to run webworkers and return results
-	return m.BestAction(results)
+// A worker's tree with its transposition table and random stream, so later searches continue where it left off
+type searchTree struct {
+	root  *TreeNode
+	table TranspositionTable
+	rng   RNG
 }
 
-func (m *MCTS) BestAction(results [][]ActionStats) *Action {
-	var (
-		bestAction *Action
-		//bestScore          = math.Inf(-1)
-		//bestActionAvgTurns = math.Inf(1)
-		bestFastWin     = math.Inf(1)
-		totalIterations uint32
-	)
+// At least one of timeLimit and iterationGoal should be set, otherwise Search never returns. Rollouts are
+// played by a UniformPolicy, cut off after maxDepth actions and scored by a HeuristicEvaluator with
+// DefaultWeights, see SetRolloutPolicy and SetEvaluator
+func NewMCTS(state State, timeLimit, iterationGoal, maxDepth uint16) *MCTS {
+	return &MCTS{
+		initialBoard:  state.Board.Clone(),
+		initialActor:  state.CurrentActor,
+		initialState:  state.Clone(),
+		timeLimit:     timeLimit,
+		iterationGoal: iterationGoal,
+		maxDepth:      maxDepth,
+		workers:       1,
+		seed:          time.Now().UnixNano(),
+		policy:        UniformPolicy{},
+		evaluator:     HeuristicEvaluator{Weights: DefaultWeights},
+	}
+}
 
-	for _, result := range results {
-		if len(result) == 0 {
+// Sets how actions are chosen during rollouts
+func (m *MCTS) SetRolloutPolicy(policy RolloutPolicy) {
+	m.policy = policy
+}
+
+// Sets how rollouts that reach maxDepth are scored. A good evaluator allows a short maxDepth, so more
+// rollouts fit in the budget
+func (m *MCTS) SetEvaluator(evaluator Evaluator) {
+	m.evaluator = evaluator
+}
+
+// Fixes the seed of the search. With an iteration goal and no time limit the search is then reproducible
+func (m *MCTS) SetSeed(seed int64) {
+	m.seed = seed
+}
+
+// Sets how many independent root trees are searched in parallel. Trees kept from earlier searches are dropped
+func (m *MCTS) SetWorkers(workers int) {
+	m.workers = max(1, workers)
+	m.trees = nil
+}
+
+// Moves the search on to state, reached by playing actions from the state of the last search. Every tree is
+// re-rooted at the node those actions lead to, keeping its statistics, and the rest of the tree is released.
+// Without actions, state.LastAction is taken as the only action played. A tree starts over if it never
+// expanded one of the actions or if the node reached doesn't hash like state, like after a different hit roll
+func (m *MCTS) Advance(state State, actions []Action) {
+	if len(actions) == 0 {
+		actions = []Action{state.LastAction}
+	}
+
+	for i, tree := range m.trees {
+		if tree == nil {
 			continue
 		}
+		node := tree.root.follow(actions)
+		if node == nil || node.State.Hash() != state.Hash() {
+			m.trees[i] = nil
+			continue
+		}
+
+		node.reroot(state)
+		node.State.reseed(&tree.rng)
+		tree.root = node
+		tree.table = TranspositionTable{}
+		tree.table.collect(node)
+	}
+
+	m.initialBoard = state.Board.Clone()
+	m.initialActor = state.CurrentActor
+	m.initialState = state.Clone()
+}
 
+func (m *MCTS) Search() (*Action, SearchMetadata) {
+	results := make([][]ActionStats, m.workers)
+	iterations := make([]uint64, m.workers)
+	carried := make([]uint64, m.workers)
+	if len(m.trees) != m.workers {
+		m.trees = make([]*searchTree, m.workers)
+	}
+
+	search := func(worker int) {
+		tree := m.trees[worker]
+		if tree == nil {
+			tree = &searchTree{
+				root:  (&TreeNode{State: m.initialState.Clone()}).Init(),
+				table: TranspositionTable{},
+				rng:   NewRNG(m.seed + int64(worker)),
+			}
+			tree.root.State.reseed(&tree.rng)
+			m.trees[worker] = tree
+		}
+		carried[worker] = tree.root.visits
+		iterations[worker] = m.runSearch(tree)
+		results[worker] = tree.root.ActionStats()
+	}
+
+	if m.workers <= 1 {
+		search(0)
+	} else {
+		var wg sync.WaitGroup
+		for i := range results {
+			wg.Add(1)
+			go func(worker int) {
+				defer wg.Done()
+				search(worker)
+			}(i)
+		}
+		wg.Wait()
+	}
+
+	action, metadata := m.BestAction(results)
+
+	metadata.Iterations = 0
+	for worker := range iterations {
+		metadata.Iterations += iterations[worker]
+		metadata.CarriedVisits += carried[worker]
+	}
+	return action, metadata
+}
+
+// Runs select/expand/simulate/backpropagate on a single tree until the time or iteration budget is spent.
+// Returns the number of iterations run
+func (m *MCTS) runSearch(tree *searchTree) uint64 {
+	root, table, rng := tree.root, tree.table, &tree.rng
+
+	var deadline time.Time
+	if m.timeLimit > 0 {
+		deadline = time.Now().Add(time.Duration(m.timeLimit) * time.Millisecond)
+	}
+
+	var iterations uint64
+	for m.iterationGoal == 0 || iterations < uint64(m.iterationGoal) {
+		if m.timeLimit > 0 && !time.Now().Before(deadline) {
+			break
+		}
+
+		node := root
+		for node.IsFullyExpanded() && len(node.children) > 0 {
+			node = node.SelectChild()
+		}
+
+		aiWin, playerWin := node.IsTerminal()
+		if !aiWin && !playerWin && !node.IsFullyExpanded() {
+			node = node.expand(rng, table)
+		}
+
+		result, depth := node.simulate(rng, m.maxDepth, m.policy, m.evaluator)
+		node.Backpropagate(result, depth)
+		iterations++
+	}
+	return iterations
+}
+
+// Identifies the same root action across the trees of different workers
+type actionKey struct {
+	actionType ActionType
+	index      uint16
+	target     uint16
+	ability    string
+}
+
+func keyOf(action Action) actionKey {
+	key := actionKey{actionType: action.ActionType, index: action.Index, target: action.Target}
+	if action.Ability != nil {
+		key.ability = action.Ability.Name
+	}
+	return key
+}
+
+type mergedStats struct {
+	action Action
+	wins   float64
+	visits float64
+	turns  float64
+}
+
+// Merges the root statistics of every worker by action and picks the most visited action. UCB1 sends most
+// visits to the best action, and a count of visits isn't swayed by a few lucky rollouts like a win rate is.
+// Between actions with as many visits the faster win is picked
+func (m *MCTS) BestAction(results [][]ActionStats) (*Action, SearchMetadata) {
+	var (
+		bestAction         *Action
+		bestScore          = math.Inf(-1)
+		bestActionAvgTurns = math.Inf(1)
+		bestFastWin        = math.Inf(1)
+		mostVisits         float64
+		totalIterations    uint64
+	)
+
+	merged := make([]*mergedStats, 0, 64)
+	byKey := make(map[actionKey]*mergedStats)
+
+	for _, result := range results {
 		for _, stats := range result {
 			if stats.Visits == 0 {
 				continue
 			}
 
-			score := float64(stats.Wins) / float64(stats.Visits)
-			avgTurns := float64(stats.Turns) / float64(stats.Visits)
-			fastWin := avgTurns / score
-
-			if fastWin < bestFastWin {
-				bestAction = &stats.Action
-				//bestScore = score
-				bestFastWin = fastWin
-				//bestActionAvgTurns = avgTurns
+			key := keyOf(stats.Action)
+			entry, exists := byKey[key]
+			if !exists {
+				entry = &mergedStats{action: stats.Action}
+				byKey[key] = entry
+				merged = append(merged, entry)
 			}
+			entry.wins += stats.Wins
+			entry.visits += float64(stats.Visits)
+			entry.turns += float64(stats.Turns)
 
 			totalIterations += stats.Visits
 		}
 	}
 
-	/* Pass to console
-	&SearchMetadata{
+	for _, stats := range merged {
+		score := stats.wins / stats.visits
+		avgTurns := stats.turns / stats.visits
+		fastWin := avgTurns / score
+
+		if stats.visits > mostVisits || (stats.visits == mostVisits && fastWin < bestFastWin) {
+			bestAction = &stats.action
+			bestScore = score
+			bestFastWin = fastWin
+			bestActionAvgTurns = avgTurns
+			mostVisits = stats.visits
+		}
+	}
+
+	return bestAction, SearchMetadata{
 		Iterations:         totalIterations,
 		BestScore:          bestScore,
 		BestActionAvgTurns: bestActionAvgTurns,
-	}*/
-
-	return bestAction
+	}
 }
@@ -0,0 +1,251 @@
+package game
+
+import "testing"
+
+func TestSearchFindsImmediateKill(t *testing.T) {
+	strike := Ability{Name: "Strike", Range: 1, TargetEnemy: true, Components: []interface{}{FlatDamage{Amount: 20}}}
+	poke := Ability{Name: "Poke", Range: 1, TargetEnemy: true, Components: []interface{}{FlatDamage{Amount: 1}}}
+
+	pieceTypes := map[Actor][2]PieceType{
+		PlayerActor: {PlayerPiece, EnemyPiece},
+		AIActor:     {EnemyPiece, PlayerPiece},
+	}
+	for actor, types := range pieceTypes {
+		// The actor to move can kill the last opposing piece right away, which could do the same next turn
+		for seed := range int64(3) {
+			state := newTestState(5, 5, map[uint16]Piece{
+				12: newTestPiece("Attacker", types[0], 10, poke, strike),
+				13: newTestPiece("Victim", types[1], 10, strike),
+			}, actor)
+
+			mcts := NewMCTS(state, 0, 300, 20)
+			mcts.SetSeed(seed)
+			action, metadata := mcts.Search()
+
+			if action == nil || action.ActionType != AbilityType || action.Ability.Name != "Strike" || action.Target != 13 {
+				t.Errorf("actor %d seed %d: picked %+v instead of the kill (%+v)", actor, seed, action, metadata)
+			}
+		}
+	}
+}
+
+func TestSelectChild(t *testing.T) {
+	child := func(wins float64, visits uint64) *TreeNode {
+		return &TreeNode{nodeStats: &nodeStats{wins: wins, visits: visits}}
+	}
+
+	good, bad, unvisited := child(9, 10), child(1, 10), child(0, 0)
+	root := &TreeNode{nodeStats: &nodeStats{visits: 20}, children: []*TreeNode{good, bad, unvisited}}
+	if selected := root.SelectChild(); selected != unvisited {
+		t.Errorf("selected %+v before the unvisited child", *selected.nodeStats)
+	}
+
+	root.children = []*TreeNode{bad, good}
+	if selected := root.SelectChild(); selected != good {
+		t.Errorf("selected %+v over the child with the better win rate", *selected.nodeStats)
+	}
+
+	// Exploration wins over a slightly better win rate with far more visits
+	rare, common := child(1, 2), child(600, 1000)
+	root = &TreeNode{nodeStats: &nodeStats{visits: 1002}, children: []*TreeNode{common, rare}}
+	if selected := root.SelectChild(); selected != rare {
+		t.Errorf("selected %+v over the rarely visited child", *selected.nodeStats)
+	}
+}
+
+func TestBestActionPicksMostVisited(t *testing.T) {
+	move := func(target uint16) Action { return Action{ActionType: MoveType, Index: 1, Target: target} }
+	mcts := NewMCTS(State{}, 0, 1, 20)
+
+	// A single rollout that won right away beats nothing with a count of visits, spread over two workers
+	lucky := ActionStats{Action: move(2), Wins: 1, Visits: 1, Turns: 1}
+	solid := []ActionStats{{Action: move(3), Wins: 150, Visits: 200, Turns: 4000}, {Action: move(3), Wins: 140, Visits: 200, Turns: 4000}}
+	action, metadata := mcts.BestAction([][]ActionStats{{lucky, solid[0]}, {solid[1]}})
+	if action == nil || action.Target != 3 || metadata.BestScore != 0.725 {
+		t.Errorf("picked %+v (%+v) over the most visited action", action, metadata)
+	}
+
+	// Equal visits go to the faster win
+	slow := ActionStats{Action: move(4), Wins: 8, Visits: 10, Turns: 200}
+	fast := ActionStats{Action: move(5), Wins: 8, Visits: 10, Turns: 50}
+	if action, _ := mcts.BestAction([][]ActionStats{{slow, fast}}); action == nil || action.Target != 5 {
+		t.Errorf("picked %+v over the faster win", action)
+	}
+}
+
+func TestSearchTreeStatistics(t *testing.T) {
+	state := State{Board: newHashTestBoard(), CurrentActor: PlayerActor}
+	state.StartCombat(1)
+
+	mcts := NewMCTS(state, 0, 500, 20)
+	mcts.SetSeed(3)
+	_, metadata := mcts.Search()
+	root := mcts.trees[0].root
+	if root.visits != metadata.Iterations || metadata.Iterations != 500 {
+		t.Errorf("root has %d visits after %d iterations, want 500", root.visits, metadata.Iterations)
+	}
+
+	// Transpositions may share statistics across branches, never along a path
+	var walk func(node *TreeNode)
+	walk = func(node *TreeNode) {
+		for ancestor := node.parent; ancestor != nil; ancestor = ancestor.parent {
+			if ancestor.nodeStats == node.nodeStats {
+				t.Fatalf("node after %+v shares its statistics with an ancestor", node.State.LastAction)
+			}
+		}
+		for _, child := range node.children {
+			walk(child)
+		}
+	}
+	walk(root)
+}
+
+// Every legal action of the root's state is either expanded or untried, exactly once, and nothing else is
+func checkRootActions(t *testing.T, root *TreeNode) {
+	t.Helper()
+	seen := map[actionKey]int{}
+	for _, child := range root.children {
+		seen[keyOf(child.State.LastAction)]++
+	}
+	for _, action := range root.untriedActions {
+		seen[keyOf(action)]++
+	}
+
+	possible := root.State.GetPossibleActions()
+	for _, action := range possible {
+		if seen[keyOf(action)] != 1 {
+			t.Errorf("legal action %+v is offered %d times", action, seen[keyOf(action)])
+		}
+	}
+	if len(seen) != len(possible) {
+		t.Errorf("the root offers %d actions, %d are legal", len(seen), len(possible))
+	}
+}
+
+func TestAdvanceKeepsTree(t *testing.T) {
+	state := State{Board: newHashTestBoard(), CurrentActor: PlayerActor}
+	state.StartCombat(1)
+
+	mcts := NewMCTS(state, 0, 300, 20)
+	mcts.SetSeed(5)
+	action, _ := mcts.Search()
+
+	for range 3 {
+		state.ExecuteAction(*action)
+		mcts.Advance(state, nil)
+		if mcts.trees[0] == nil {
+			t.Fatalf("advancing by %+v dropped the tree", *action)
+		}
+		root := mcts.trees[0].root
+		carried := root.visits
+		checkRootActions(t, root)
+
+		var metadata SearchMetadata
+		action, metadata = mcts.Search()
+		if metadata.Iterations != 300 || metadata.CarriedVisits != carried || carried == 0 {
+			t.Errorf("searched %d iterations carrying %d visits, want 300 carrying %d", metadata.Iterations,
+				metadata.CarriedVisits, carried)
+		}
+		if root.visits != carried+300 {
+			t.Errorf("root has %d visits, want %d", root.visits, carried+300)
+		}
+	}
+}
+
+func TestRerootDropsIllegalActions(t *testing.T) {
+	state := State{Board: newHashTestBoard(), CurrentActor: PlayerActor}
+	state.StartCombat(1)
+
+	rng := NewRNG(1)
+	root := (&TreeNode{State: state.Clone()}).Init()
+	for !root.IsFullyExpanded() {
+		root.expand(&rng, nil)
+	}
+
+	// A stun the tree never saw takes every action of the piece on square 1 away
+	stunned := state.Clone()
+	stunned.Board.BoardArray[1].AddStatus(StatusEffect{Name: "Stun", Kind: StunStatus, Duration: 1})
+	stunned.Board.RefreshSquare(1)
+	root.reroot(stunned)
+
+	for _, child := range root.children {
+		if child.State.LastAction.Index == 1 {
+			t.Errorf("kept the child for %+v of the stunned piece", child.State.LastAction)
+		}
+	}
+	for _, action := range root.untriedActions {
+		if action.Index == 1 {
+			t.Errorf("offers %+v of the stunned piece", action)
+		}
+	}
+	checkRootActions(t, root)
+	if len(root.children) == 0 {
+		t.Error("dropped the children of the other piece as well")
+	}
+}
+
+// The search plays on clones of the combat state, it must not learn the combat's upcoming rolls from them
+func TestSearchIgnoresCombatRolls(t *testing.T) {
+	gamble := Ability{Name: "Gamble", Range: 1, TargetEnemy: true, Components: []interface{}{FlatHitChance{Chance: 0.5}, FlatDamage{Amount: 20}}}
+	poke := Ability{Name: "Poke", Range: 1, TargetEnemy: true, Components: []interface{}{FlatDamage{Amount: 1}}}
+	strike := Ability{Name: "Strike", Range: 1, TargetEnemy: true, Components: []interface{}{FlatDamage{Amount: 20}}}
+
+	var first *Action
+	for seed := range int64(30) {
+		state := newTestState(5, 5, map[uint16]Piece{
+			12: newTestPiece("Gambler", EnemyPiece, 10, poke, gamble),
+			13: newTestPiece("Victim", PlayerPiece, 10, strike),
+		}, AIActor)
+		state.rng = NewRNG(seed)
+
+		mcts := NewMCTS(state, 0, 200, 20)
+		mcts.SetSeed(1)
+		action, _ := mcts.Search()
+		if first == nil {
+			first = action
+		} else if keyOf(*action) != keyOf(*first) {
+			t.Fatalf("combat seed %d: picked %+v, seed 0 picked %+v", seed, *action, *first)
+		}
+	}
+}
+
+// Scores every state the same, so a rollout scored 0.5 was cut off
+type constantEvaluator float64
+
+func (e constantEvaluator) Evaluate(state *State) float64 {
+	return float64(e)
+}
+
+// Plays the first ability listed
+type firstAbilityPolicy struct{}
+
+func (firstAbilityPolicy) ChooseAction(state *State, actions []Action, rng *RNG) Action {
+	for _, action := range actions {
+		if action.ActionType == AbilityType {
+			return action
+		}
+	}
+	return actions[0]
+}
+
+func TestSimulateScoresGameEndAtMaxDepth(t *testing.T) {
+	strike := Ability{Name: "Strike", Range: 1, TargetEnemy: true, Components: []interface{}{FlatDamage{Amount: 20}}}
+	state := newTestState(5, 5, map[uint16]Piece{
+		12: newTestPiece("Attacker", EnemyPiece, 10, strike),
+		13: newTestPiece("Victim", PlayerPiece, 10),
+	}, AIActor)
+	rng := NewRNG(1)
+
+	// The last action the rollout may play kills the victim
+	node := (&TreeNode{State: state}).Init()
+	if result, depth := node.simulate(&rng, 1, firstAbilityPolicy{}, constantEvaluator(0.5)); result != 1 || depth != 1 {
+		t.Errorf("rollout ending the game with its last action scored %v after %d actions, want 1", result, depth)
+	}
+
+	// No actions at all on a game that is already over
+	state.ExecuteAction(Action{AbilityType, 12, 13, &state.Board.BoardArray[12].Abilities[0]})
+	node = (&TreeNode{State: state}).Init()
+	if result, _ := node.simulate(&rng, 0, firstAbilityPolicy{}, constantEvaluator(0.5)); result != 1 {
+		t.Errorf("rollout of a won game scored %v, want 1", result)
+	}
+}
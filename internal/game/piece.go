@@ -1,6 +1,9 @@
 package game
 
-import "math"
+import (
+	"fmt"
+	"math"
+)
 
 type PieceType uint8
 
@@ -12,33 +15,147 @@ const (
 	PlayerAreaPiece
 )
 
+// Every piece gets this budget per turn unless it sets its own. A move costs a move and an action, an
+// ability costs an action, so by default a piece can move and act, or act twice
+const (
+	DefaultActionsPerTurn uint8 = 2
+	DefaultMovesPerTurn   uint8 = 1
+)
+
 type Piece struct {
-	Name       string
-	Abilities  []Ability
-	Index      uint8
-	PieceType  PieceType
-	BlocksLOS  bool
-	BlocksMove bool
-	MoveRange  uint8
-	Stats      StatStruct
+	Name           string
+	Abilities      []Ability
+	Index          uint16
+	PieceType      PieceType
+	BlocksLOS      bool
+	BlocksMove     bool
+	MoveRange      uint8
+	ActionsPerTurn uint8
+	MovesPerTurn   uint8
+	ActionsLeft    uint8
+	MovesLeft      uint8
+	Stats          StatStruct
+	StatusEffects  []StatusEffect
+	Cooldowns      []uint8 // Turns left per ability, same order as Abilities. nil while nothing is on cooldown
 }
 
 func (p Piece) Clone() Piece {
 	return Piece{
-		Name:       p.Name,
-		Abilities:  p.Abilities,
-		Index:      p.Index,
-		PieceType:  p.PieceType,
-		BlocksLOS:  p.BlocksLOS,
-		BlocksMove: p.BlocksMove,
-		MoveRange:  p.MoveRange,
-		Stats:      p.Stats.clone(),
+		Name:           p.Name,
+		Abilities:      p.Abilities,
+		Index:          p.Index,
+		PieceType:      p.PieceType,
+		BlocksLOS:      p.BlocksLOS,
+		BlocksMove:     p.BlocksMove,
+		MoveRange:      p.MoveRange,
+		ActionsPerTurn: p.ActionsPerTurn,
+		MovesPerTurn:   p.MovesPerTurn,
+		ActionsLeft:    p.ActionsLeft,
+		MovesLeft:      p.MovesLeft,
+		Stats:          p.Stats.clone(),
+		StatusEffects:  p.cloneStatusEffects(),
+		Cooldowns:      append([]uint8(nil), p.Cooldowns...),
+	}
+}
+
+func (p *Piece) CooldownLeft(slot int) uint8 {
+	if slot >= len(p.Cooldowns) {
+		return 0
+	}
+	return p.Cooldowns[slot]
+}
+
+// Checks that the ability in slot is off cooldown and that the piece has the resource for it
+func (p *Piece) CanAfford(slot int) bool {
+	return p.CooldownLeft(slot) == 0 && p.Stats.Resource.Current >= p.Abilities[slot].Cost
+}
+
+// Finds the slot of an ability, by identity first since actions point into the shared Abilities slice
+func (p *Piece) abilitySlot(ability *Ability) int {
+	for i := range p.Abilities {
+		if &p.Abilities[i] == ability {
+			return i
+		}
+	}
+	for i := range p.Abilities {
+		if p.Abilities[i].Name == ability.Name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Spends the resource and starts the cooldown of the ability
+func (p *Piece) payForAbility(ability *Ability) {
+	slot := p.abilitySlot(ability)
+	if slot < 0 {
+		return
+	}
+
+	p.Stats.Resource.Spend(ability.Cost)
+	if ability.Cooldown > 0 {
+		if p.Cooldowns == nil {
+			p.Cooldowns = make([]uint8, len(p.Abilities))
+		}
+		p.Cooldowns[slot] = ability.Cooldown
+	}
+}
+
+// Counts every cooldown down by one turn
+func (p *Piece) tickCooldowns() {
+	ready := true
+	for i := range p.Cooldowns {
+		if p.Cooldowns[i] > 0 {
+			p.Cooldowns[i]--
+		}
+		ready = ready && p.Cooldowns[i] == 0
+	}
+	if ready {
+		p.Cooldowns = nil
+	}
+}
+
+// Refills the turn budget, pieces without their own budget get the defaults
+func (p *Piece) ResetBudget() {
+	if p.ActionsPerTurn == 0 {
+		p.ActionsPerTurn = DefaultActionsPerTurn
+	}
+	if p.MovesPerTurn == 0 {
+		p.MovesPerTurn = DefaultMovesPerTurn
+	}
+	p.ActionsLeft = p.ActionsPerTurn
+	p.MovesLeft = p.MovesPerTurn
+}
+
+func (p *Piece) CanMove() bool {
+	return p.CanAct() && p.MovesLeft > 0 && !p.HasStatus(RootStatus)
+}
+
+func (p *Piece) CanAct() bool {
+	return p.ActionsLeft > 0 && !p.HasStatus(StunStatus)
+}
+
+func (p *Piece) CanUseAbilities() bool {
+	return p.CanAct() && len(p.Abilities) > 0 && !p.HasStatus(SilenceStatus)
+}
+
+func (p *Piece) spendMove() {
+	if p.MovesLeft > 0 {
+		p.MovesLeft--
 	}
+	p.spendAction()
 }
 
+func (p *Piece) spendAction() {
+	if p.ActionsLeft > 0 {
+		p.ActionsLeft--
+	}
+}
+
+// Lists every square the piece can reach with its MoveRange, see Board.CalculateRange
 func (p *Piece) GetValidMoves(board Board) []Action {
 	validMoves := []Action{}
-	validMoveIndexes := board.CalculateRange(p.Index, p.MoveRange, false)
+	validMoveIndexes := board.CalculateRange(p.Index, p.MoveRange)
 
 	for _, index := range validMoveIndexes {
 		validMoves = append(validMoves, Action{MoveType, p.Index, index, nil})
@@ -47,26 +164,37 @@ func (p *Piece) GetValidMoves(board Board) []Action {
 	return validMoves
 }
 
-// Add more checks for if target is allowed (and potentially optimize for only Player/AIPiece)
-func (p *Piece) GetValidAbilities(board Board) []Action {
+// Lists every legal target of every ability the piece can afford, see Ability.CanTarget
+func (p *Piece) GetValidAbilities(state *State) []Action {
 	validAbilities := []Action{}
 
-	for _, ability := range p.Abilities {
-		validAbilityIndexes := board.CalculateRange(p.Index, ability.Range, true)
+	for i := range p.Abilities {
+		ability := &p.Abilities[i]
+		if !p.CanAfford(i) {
+			continue
+		}
 
-		for _, index := range validAbilityIndexes {
-			validAbilities = append(validAbilities, Action{AbilityType, p.Index, index, &ability})
+		for _, index := range state.Board.SquaresWithin(p.Index, ability.Range) {
+			if !ability.CanTarget(state, p.Index, index) {
+				continue
+			}
+			validAbilities = append(validAbilities, Action{AbilityType, p.Index, index, ability})
 		}
 	}
 
 	return validAbilities
 }
 
+func (p *Piece) IsDead() bool {
+	return (p.PieceType == PlayerPiece || p.PieceType == EnemyPiece) && p.Stats.Health.IsEmpty()
+}
+
 type StatType uint8
 
 const (
 	FlatStat StatType = iota
 	HealthStat
+	ResourceStat
 )
 
 type Stat struct {
@@ -77,11 +205,18 @@ type Stat struct {
 	Total        float64
 }
 
+// Total = Base * (1 + PercentBonus) + FlatBonus. Percent bonuses stack additively and only scale the
+// base, never flat bonuses, so the order items are equipped in doesn't matter
+func NewStat(statType StatType, base float64) Stat {
+	stat := Stat{Type: statType, Base: base}
+	stat.CalculateTotal()
+	return stat
+}
+
 func (s *Stat) CalculateTotal() {
 	preCalced := s.Base*(1+s.PercentBonus) + s.FlatBonus
-	if s.Type == HealthStat {
+	if s.Type == HealthStat || s.Type == ResourceStat {
 		preCalced = math.Max(0, preCalced)
-		return
 	}
 	s.Total = preCalced
 }
@@ -96,12 +231,110 @@ func (s *Stat) AddPercentBonus(amount float64) {
 	s.CalculateTotal()
 }
 
+type ModifierType string
+
+const (
+	FlatModifier    ModifierType = "flat"
+	PercentModifier ModifierType = "percent"
+)
+
+// A bonus to one stat, e.g. from an item. Stat is the name used by StatStruct.Get
+type StatModifier struct {
+	Stat   string       `json:"stat"`
+	Type   ModifierType `json:"type"`
+	Amount float64      `json:"amount"`
+}
+
+// Accuracy, Evasion and CritChance are fractions, e.g. 0.9 for 90%. Resource is mana/energy for abilities
 type StatStruct struct {
-	Health Stat
+	Health     Pool
+	Resource   Pool
+	Attack     Stat
+	Defense    Stat
+	MagicPower Stat
+	Resistance Stat
+	Speed      Stat
+	Accuracy   Stat
+	Evasion    Stat
+	CritChance Stat
+}
+
+// Looks up a pool by the name used in data files, nil if there is no such pool
+func (s *StatStruct) GetPool(name string) *Pool {
+	switch name {
+	case "health":
+		return &s.Health
+	case "resource":
+		return &s.Resource
+	}
+	return nil
+}
+
+// Looks up a stat by the name used in data files, nil if there is no such stat. For pools this is their max
+func (s *StatStruct) Get(name string) *Stat {
+	if pool := s.GetPool(name); pool != nil {
+		return &pool.Max
+	}
+
+	switch name {
+	case "attack":
+		return &s.Attack
+	case "defense":
+		return &s.Defense
+	case "magicPower":
+		return &s.MagicPower
+	case "resistance":
+		return &s.Resistance
+	case "speed":
+		return &s.Speed
+	case "accuracy":
+		return &s.Accuracy
+	case "evasion":
+		return &s.Evasion
+	case "critChance":
+		return &s.CritChance
+	}
+	return nil
+}
+
+// Both Stat and Pool take bonuses, pools also keep their current value in line with the new max
+type bonusTarget interface {
+	AddFlatBonus(amount float64)
+	AddPercentBonus(amount float64)
+}
+
+func (s *StatStruct) ApplyModifier(modifier StatModifier) error {
+	var target bonusTarget
+	if pool := s.GetPool(modifier.Stat); pool != nil {
+		target = pool
+	} else if stat := s.Get(modifier.Stat); stat != nil {
+		target = stat
+	} else {
+		return fmt.Errorf("unknown stat %q", modifier.Stat)
+	}
+
+	switch modifier.Type {
+	case FlatModifier:
+		target.AddFlatBonus(modifier.Amount)
+	case PercentModifier:
+		target.AddPercentBonus(modifier.Amount)
+	default:
+		return fmt.Errorf("unknown modifier type %q", modifier.Type)
+	}
+	return nil
 }
 
 func (s StatStruct) clone() StatStruct {
 	return StatStruct{
-		Health: s.Health,
+		Health:     s.Health,
+		Resource:   s.Resource,
+		Attack:     s.Attack,
+		Defense:    s.Defense,
+		MagicPower: s.MagicPower,
+		Resistance: s.Resistance,
+		Speed:      s.Speed,
+		Accuracy:   s.Accuracy,
+		Evasion:    s.Evasion,
+		CritChance: s.CritChance,
 	}
 }
@@ -0,0 +1,96 @@
+package game
+
+import "math"
+
+// A stat that is spent and refilled, like health or resource. Max follows the usual Stat math and
+// Current is what is left of it. Shield absorbs damage before Current and is not capped by Max.
+//
+// When Max changes, a gain is added to Current as well and a loss only clamps Current to the new Max.
+// So a +10 max health buff heals 10 and removing it again never kills a piece.
+type Pool struct {
+	Max     Stat
+	Current float64
+	Shield  float64
+}
+
+func NewPool(statType StatType, base float64) Pool {
+	max := NewStat(statType, base)
+	return Pool{Max: max, Current: max.Total}
+}
+
+func (p *Pool) IsEmpty() bool {
+	return p.Current <= 0
+}
+
+func (p *Pool) Fill() {
+	p.Current = p.Max.Total
+}
+
+// Takes damage from the shield first, then from Current. Returns how much was absorbed by the shield,
+// how much was taken from Current and whether this damage emptied the pool
+func (p *Pool) Damage(amount float64) (absorbed, dealt float64, emptied bool) {
+	if amount <= 0 {
+		return 0, 0, false
+	}
+
+	absorbed = math.Min(p.Shield, amount)
+	p.Shield -= absorbed
+	amount -= absorbed
+
+	wasEmpty := p.IsEmpty()
+	dealt = math.Min(p.Current, amount)
+	p.Current -= dealt
+
+	return absorbed, dealt, !wasEmpty && p.IsEmpty()
+}
+
+// Spends from Current without touching the shield. Returns false and spends nothing if there isn't enough
+func (p *Pool) Spend(amount float64) bool {
+	if amount > p.Current {
+		return false
+	}
+	p.Current -= amount
+	return true
+}
+
+// Heals up to Max and returns the amount healed
+func (p *Pool) Heal(amount float64) float64 {
+	if amount <= 0 {
+		return 0
+	}
+	healed := math.Min(p.Max.Total-p.Current, amount)
+	healed = math.Max(0, healed)
+	p.Current += healed
+	return healed
+}
+
+// Heals up to Max and turns whatever is left into shield. Returns the amounts healed and shielded
+func (p *Pool) Overheal(amount float64) (healed, shielded float64) {
+	healed = p.Heal(amount)
+	shielded = math.Max(0, amount-healed)
+	p.Shield += shielded
+	return healed, shielded
+}
+
+func (p *Pool) AddShield(amount float64) {
+	p.Shield = math.Max(0, p.Shield+amount)
+}
+
+func (p *Pool) AddFlatBonus(amount float64) {
+	previous := p.Max.Total
+	p.Max.AddFlatBonus(amount)
+	p.rescale(previous)
+}
+
+func (p *Pool) AddPercentBonus(amount float64) {
+	previous := p.Max.Total
+	p.Max.AddPercentBonus(amount)
+	p.rescale(previous)
+}
+
+func (p *Pool) rescale(previousMax float64) {
+	if gain := p.Max.Total - previousMax; gain > 0 {
+		p.Current += gain
+	}
+	p.Current = math.Min(p.Current, p.Max.Total)
+}
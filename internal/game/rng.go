@@ -0,0 +1,44 @@
+package game
+
+import "math/bits"
+
+// Small splitmix64 generator. It is a plain value so State.Clone copies it, which keeps replays and
+// MCTS rollouts deterministic for a given seed
+type RNG struct {
+	state uint64
+}
+
+func NewRNG(seed int64) RNG {
+	return RNG{state: uint64(seed)}
+}
+
+func (r *RNG) Uint64() uint64 {
+	r.state += 0x9e3779b97f4a7c15
+	return mix64(r.state)
+}
+
+// The splitmix64 finalizer, also used to spread hash keys
+func mix64(z uint64) uint64 {
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// Returns a number in [0, n). Panics if n <= 0 like math/rand
+func (r *RNG) Intn(n int) int {
+	if n <= 0 {
+		panic("invalid argument to Intn")
+	}
+	hi, _ := bits.Mul64(r.Uint64(), uint64(n))
+	return int(hi)
+}
+
+// Returns a number in [0, 1)
+func (r *RNG) Float64() float64 {
+	return float64(r.Uint64()>>11) / (1 << 53)
+}
+
+// Returns a number in [0, 1)
+func (r *RNG) Float32() float32 {
+	return float32(r.Uint64()>>40) / (1 << 24)
+}
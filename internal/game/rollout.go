@@ -0,0 +1,131 @@
+package game
+
+import "math"
+
+// Picks the next action during an MCTS rollout. actions is never empty and all randomness has to come from rng
+type RolloutPolicy interface {
+	ChooseAction(state *State, actions []Action, rng *RNG) Action
+}
+
+// Picks every action with the same chance
+type UniformPolicy struct{}
+
+func (UniformPolicy) ChooseAction(state *State, actions []Action, rng *RNG) Action {
+	return actions[rng.Intn(len(actions))]
+}
+
+// Picks a uniformly random action with chance Epsilon, otherwise the best action by ActionHeuristic: attack if
+// possible, else approach the nearest enemy. Ties are broken randomly
+type EpsilonGreedyPolicy struct {
+	Epsilon float64
+}
+
+func (p EpsilonGreedyPolicy) ChooseAction(state *State, actions []Action, rng *RNG) Action {
+	if rng.Float64() < p.Epsilon {
+		return actions[rng.Intn(len(actions))]
+	}
+
+	bestScore := math.Inf(-1)
+	best := 0
+	ties := 0
+	for i, action := range actions {
+		score := ActionHeuristic(state, action)
+		switch {
+		case score > bestScore:
+			bestScore, best, ties = score, i, 1
+		case score == bestScore:
+			// Reservoir sampling keeps every tied action equally likely
+			ties++
+			if rng.Intn(ties) == 0 {
+				best = i
+			}
+		}
+	}
+	return actions[best]
+}
+
+// Picks actions with a chance proportional to exp(ActionHeuristic / Temperature). A low temperature is close
+// to greedy, a high one close to uniform
+type SoftmaxPolicy struct {
+	Temperature float64
+}
+
+func (p SoftmaxPolicy) ChooseAction(state *State, actions []Action, rng *RNG) Action {
+	temperature := p.Temperature
+	if temperature <= 0 {
+		temperature = 1
+	}
+
+	weights := make([]float64, len(actions))
+	maxScore := math.Inf(-1)
+	for i, action := range actions {
+		weights[i] = ActionHeuristic(state, action)
+		maxScore = max(maxScore, weights[i])
+	}
+
+	// Shifting by the highest score keeps exp from overflowing without changing the distribution
+	total := 0.0
+	for i := range weights {
+		weights[i] = math.Exp((weights[i] - maxScore) / temperature)
+		total += weights[i]
+	}
+
+	pick := rng.Float64() * total
+	for i, weight := range weights {
+		pick -= weight
+		if pick < 0 {
+			return actions[i]
+		}
+	}
+	return actions[len(actions)-1]
+}
+
+// Cheap score of an action for the actor to move, used by the informed rollout policies:
+//   - 3 for an ability aimed at an opposing piece
+//   - 1 for any other ability
+//   - 1 plus the steps gained towards the nearest opposing piece for a move, scaled into (-1, 1) so a move never
+//     ties with an attack and even retreating scores above ending the turn
+//   - 0 for ending the turn
+func ActionHeuristic(state *State, action Action) float64 {
+	switch action.ActionType {
+	case AbilityType:
+		target := state.Board.BoardArray[action.Target].PieceType
+		if target == opposingPieceType(state.CurrentActor) {
+			return 3
+		}
+		return 1
+	case MoveType:
+		opponents := state.pieceIndexes(opposingActor(state.CurrentActor))
+		before := nearestDistance(&state.Board, action.Index, opponents)
+		after := nearestDistance(&state.Board, action.Target, opponents)
+		gained := float64(before - after)
+		return 1 + gained/(1+math.Abs(gained))
+	}
+	return 0
+}
+
+func opposingActor(actor Actor) Actor {
+	if actor == PlayerActor {
+		return AIActor
+	}
+	return PlayerActor
+}
+
+func opposingPieceType(actor Actor) PieceType {
+	if actor == PlayerActor {
+		return EnemyPiece
+	}
+	return PlayerPiece
+}
+
+// Steps from index to the closest of indexes, 0 without any
+func nearestDistance(board *Board, index uint16, indexes []uint16) int {
+	nearest := 0
+	for i, other := range indexes {
+		distance := board.Distance(index, other)
+		if i == 0 || distance < nearest {
+			nearest = distance
+		}
+	}
+	return nearest
+}
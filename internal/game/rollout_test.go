@@ -0,0 +1,59 @@
+package game
+
+import "testing"
+
+func TestActionHeuristicRanksAttacksFirst(t *testing.T) {
+	strike := Ability{Name: "Strike", Range: 1, TargetEnemy: true, Components: []interface{}{FlatDamage{Amount: 1}}}
+	mend := Ability{Name: "Mend", Range: 1, TargetSelf: true, Components: []interface{}{FlatHeal{Amount: 1}}}
+
+	// A fast piece far from one enemy and next to another, so moves can gain or lose several steps
+	hero := newTestPiece("Hero", PlayerPiece, 10, strike, mend)
+	hero.MoveRange = 6
+	state := newTestState(8, 8, map[uint16]Piece{
+		27: hero,
+		28: newTestPiece("Near", EnemyPiece, 10),
+		63: newTestPiece("Far", EnemyPiece, 10),
+	}, PlayerActor)
+
+	var moves []float64
+	for _, action := range state.GetPossibleActions() {
+		score := ActionHeuristic(&state, action)
+		switch {
+		case action.ActionType == EndTurnType:
+			if score != 0 {
+				t.Errorf("ending the turn scored %v", score)
+			}
+		case action.ActionType == AbilityType && action.Ability.Name == "Strike":
+			if score != 3 {
+				t.Errorf("attacking scored %v", score)
+			}
+		case action.ActionType == AbilityType:
+			if score != 1 {
+				t.Errorf("%s on %d scored %v", action.Ability.Name, action.Target, score)
+			}
+		default:
+			if score <= 0 || score >= 2 {
+				t.Errorf("moving from %d to %d scored %v", action.Index, action.Target, score)
+			}
+			moves = append(moves, score)
+		}
+	}
+
+	if len(moves) == 0 {
+		t.Error("no moves were scored")
+	}
+
+	// Moves still rank by the steps gained, from the middle of the board towards the far corner
+	state = newTestState(8, 8, map[uint16]Piece{
+		27: hero,
+		63: newTestPiece("Far", EnemyPiece, 10),
+	}, PlayerActor)
+	var previous float64
+	for i, target := range []uint16{26, 35, 36, 45} {
+		score := ActionHeuristic(&state, Action{ActionType: MoveType, Index: 27, Target: target})
+		if i > 0 && score <= previous {
+			t.Errorf("moving to %d scored %v, not above %v", target, score, previous)
+		}
+		previous = score
+	}
+}
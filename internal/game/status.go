@@ -0,0 +1,192 @@
+package game
+
+type StatusKind uint8
+
+const (
+	PoisonStatus StatusKind = iota
+	BurnStatus
+	StunStatus    // No actions at all
+	RootStatus    // No moves
+	SilenceStatus // No abilities
+	ShieldStatus
+	StatStatus // Buff or debuff through Modifier
+)
+
+// What happens when a status is applied to a piece that already has a status with the same name
+type StackingRule uint8
+
+const (
+	RefreshStacking StackingRule = iota // Keep the stacks, reset the duration
+	StackStacking                       // Add a stack up to MaxStacks and reset the duration
+	ReplaceStacking                     // Remove the old status and apply the new one
+)
+
+// Duration counts the owner's turns and goes down every time the status ticks, which happens in TickPhase
+// of the owner's turn. Control statuses like stun should tick at TurnEndPhase, otherwise they run out
+// before the turn they were meant to block.
+//
+// Amount is damage per stack per tick for poison and burn, and the shield per stack for shields
+type StatusEffect struct {
+	Name       string
+	Kind       StatusKind
+	Duration   uint8
+	Stacks     uint8
+	MaxStacks  uint8 // 0 means no limit
+	Stacking   StackingRule
+	TickPhase  TurnPhase
+	Amount     float64
+	Modifier   StatModifier
+	shieldLeft float64 // What is left of the shield the status added, after damage absorbed by it
+}
+
+func init() {
+	RegisterTurnHook(TurnStartPhase, tickStatusEffects(TurnStartPhase))
+	RegisterTurnHook(TurnEndPhase, tickStatusEffects(TurnEndPhase))
+}
+
+func (p *Piece) HasStatus(kind StatusKind) bool {
+	for _, effect := range p.StatusEffects {
+		if effect.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Applies the status following its stacking rule
+func (p *Piece) AddStatus(effect StatusEffect) {
+	if effect.Stacks == 0 {
+		effect.Stacks = 1
+	}
+
+	for i := range p.StatusEffects {
+		existing := &p.StatusEffects[i]
+		if existing.Name != effect.Name {
+			continue
+		}
+
+		switch existing.Stacking {
+		case RefreshStacking:
+			existing.Duration = max(existing.Duration, effect.Duration)
+		case StackStacking:
+			existing.Duration = max(existing.Duration, effect.Duration)
+			if existing.MaxStacks == 0 || existing.Stacks < existing.MaxStacks {
+				existing.Stacks++
+				p.applyStack(existing)
+			}
+		case ReplaceStacking:
+			p.removeStatus(i)
+			p.AddStatus(effect)
+		}
+		return
+	}
+
+	effect.shieldLeft = 0
+	p.StatusEffects = append(p.StatusEffects, effect)
+	for range effect.Stacks {
+		p.applyStack(&p.StatusEffects[len(p.StatusEffects)-1])
+	}
+}
+
+// Applies the lasting part of one stack, ticking effects like poison do nothing here
+func (p *Piece) applyStack(effect *StatusEffect) {
+	switch effect.Kind {
+	case ShieldStatus:
+		p.Stats.Health.AddShield(effect.Amount)
+		effect.shieldLeft += effect.Amount
+	case StatStatus:
+		// Modifiers are validated when the status is defined
+		_ = p.Stats.ApplyModifier(effect.Modifier)
+	}
+}
+
+// Removes the status and undoes its lasting part. A shield only takes what is left of its own shield, the
+// rest of the pool's shield belongs to other statuses or to overhealing
+func (p *Piece) removeStatus(i int) {
+	effect := p.StatusEffects[i]
+	switch effect.Kind {
+	case ShieldStatus:
+		p.Stats.Health.AddShield(-effect.shieldLeft)
+	case StatStatus:
+		modifier := effect.Modifier
+		modifier.Amount = -modifier.Amount * float64(effect.Stacks)
+		_ = p.Stats.ApplyModifier(modifier)
+	}
+	p.StatusEffects = append(p.StatusEffects[:i], p.StatusEffects[i+1:]...)
+}
+
+// Takes shield the health pool absorbed damage with from the shield statuses, oldest first. Whatever they
+// don't cover came from overhealing
+func (p *Piece) absorbShield(amount float64) {
+	for i := range p.StatusEffects {
+		effect := &p.StatusEffects[i]
+		if amount <= 0 {
+			return
+		}
+		if effect.Kind != ShieldStatus {
+			continue
+		}
+		taken := min(effect.shieldLeft, amount)
+		effect.shieldLeft -= taken
+		amount -= taken
+	}
+}
+
+// Ticks the statuses of every piece of the actor whose turn it is
+func tickStatusEffects(phase TurnPhase) TurnHook {
+	return func(s *State) {
+		// Copied because pieces that die from damage over time are removed from the index list
+		indexes := append([]uint16(nil), s.pieceIndexes(s.CurrentActor)...)
+		for _, idx := range indexes {
+			s.tickPiece(idx, phase)
+		}
+	}
+}
+
+func (s *State) tickPiece(index uint16, phase TurnPhase) {
+	piece := &s.Board.BoardArray[index]
+	damage := 0.0
+
+	for i := 0; i < len(piece.StatusEffects); i++ {
+		effect := &piece.StatusEffects[i]
+		if effect.TickPhase != phase {
+			continue
+		}
+
+		if effect.Kind == PoisonStatus || effect.Kind == BurnStatus {
+			damage += effect.Amount * float64(effect.Stacks)
+		}
+
+		if effect.Duration > 0 {
+			effect.Duration--
+		}
+		if effect.Duration == 0 {
+			piece.removeStatus(i)
+			i--
+		}
+	}
+
+	// Expired statuses can change max health
+	s.Board.RefreshSquare(index)
+
+	// Last, as the piece is gone from the board if this kills it
+	if damage > 0 {
+		s.DamagePiece(index, damage)
+	}
+}
+
+func (p *Piece) cloneStatusEffects() []StatusEffect {
+	if len(p.StatusEffects) == 0 {
+		return nil
+	}
+	return append([]StatusEffect(nil), p.StatusEffects...)
+}
+
+// Ability component that applies a status to the target
+type ApplyStatusEffect struct {
+	Effect StatusEffect
+}
+
+func (c ApplyStatusEffect) ApplyStatus(caster, target *Piece) {
+	target.AddStatus(c.Effect)
+}
@@ -0,0 +1,32 @@
+package game
+
+import "testing"
+
+func TestExpiringShieldKeepsOtherShields(t *testing.T) {
+	tests := []struct {
+		name   string
+		damage float64
+		// Shield left once the status expired, all of it from overhealing
+		want float64
+	}{
+		{"untouched", 0, 5},
+		{"partly used", 2, 5},
+		{"used up", 4, 5},
+		{"into the overheal", 6, 3},
+	}
+
+	for _, test := range tests {
+		state := newTestState(2, 1, map[uint16]Piece{0: newTestPiece("Hero", PlayerPiece, 10)}, PlayerActor)
+		piece := &state.Board.BoardArray[0]
+		piece.Stats.Health.Overheal(5)
+		piece.AddStatus(StatusEffect{Name: "Ward", Kind: ShieldStatus, Duration: 1, Amount: 2, Stacking: StackStacking})
+		piece.AddStatus(StatusEffect{Name: "Ward", Kind: ShieldStatus, Duration: 1, Amount: 2, Stacking: StackStacking})
+
+		state.DamagePiece(0, test.damage)
+		piece.removeStatus(0)
+		if piece.Stats.Health.Shield != test.want || piece.Stats.Health.Current != 10 {
+			t.Errorf("%s: %v shield and %v health left, want %v shield", test.name, piece.Stats.Health.Shield,
+				piece.Stats.Health.Current, test.want)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package game
+
+import "math"
+
+// Health and shield are hashed in this many buckets of max health, resource in as many of max resource, so
+// small differences still share a hash
+const (
+	HealthBuckets   = 8
+	ResourceBuckets = 8
+)
+
+var (
+	actorKeys = [...]uint64{mix64(0x5a0b1e57), mix64(0xa1ac7015)}
+	phaseKeys = [...]uint64{mix64(0x57a27), mix64(0xac7105), mix64(0xe2d)}
+)
+
+const turnSalt = 0x7e2a
+
+// Zobrist style hash of the position: every piece's identity, square, health, shield and resource buckets,
+// budget, cooldowns and statuses, the actor to move, the turn phase and the turn. Only the random stream is
+// left out
+func (s *State) Hash() uint64 {
+	return s.Board.hash ^ actorKeys[s.CurrentActor] ^ phaseKeys[s.phase] ^ mix64(turnSalt<<16|uint64(s.turn))
+}
+
+// Hash of the pieces on the board, kept up to date by UpdateSquare
+func (b *Board) Hash() uint64 {
+	return b.hash
+}
+
+// Rehashes the square after its piece was changed in place, like damage, a status or spending its budget
+func (b *Board) RefreshSquare(index uint16) {
+	b.UpdateSquare(index, b.BoardArray[index])
+}
+
+// Key of a piece standing on index. Empty squares are 0, so they don't change the hash
+func pieceKey(piece *Piece, index uint16) uint64 {
+	if piece.PieceType == EmptyPiece {
+		return 0
+	}
+
+	// FNV-1a of the name, so pieces are told apart without a registry of piece kinds
+	key := fnv1a(piece.Name)
+	key ^= uint64(piece.PieceType)<<56 | uint64(piece.ActionsLeft)<<40 | uint64(piece.MovesLeft)<<32 |
+		uint64(index)<<16 | poolBuckets(piece)
+	key = mix64(key)
+
+	// Cooldowns and statuses are combined with XOR so their order doesn't matter, ready abilities count as
+	// no cooldown at all
+	var extra uint64
+	for slot, cooldown := range piece.Cooldowns {
+		if cooldown > 0 {
+			extra ^= mix64(uint64(slot)<<8 | uint64(cooldown))
+		}
+	}
+	for _, effect := range piece.StatusEffects {
+		extra ^= mix64(fnv1a(effect.Name) ^ uint64(effect.Duration)<<8 ^ uint64(effect.Stacks))
+	}
+	return mix64(key ^ extra)
+}
+
+func fnv1a(s string) uint64 {
+	key := uint64(14695981039346656037)
+	for i := 0; i < len(s); i++ {
+		key ^= uint64(s[i])
+		key *= 1099511628211
+	}
+	return key
+}
+
+// The health bucket in bits 48 to 55, the resource bucket in bits 8 to 15 and the shield bucket in bits 0
+// to 7. All 0 for pieces that don't fight
+func poolBuckets(piece *Piece) uint64 {
+	if piece.PieceType != PlayerPiece && piece.PieceType != EnemyPiece {
+		return 0
+	}
+	health, resource := &piece.Stats.Health, &piece.Stats.Resource
+	return min(bucket(health.Current, health.Max.Total, HealthBuckets), HealthBuckets)<<48 |
+		min(bucket(resource.Current, resource.Max.Total, ResourceBuckets), ResourceBuckets)<<8 |
+		bucket(health.Shield, health.Max.Total, HealthBuckets)
+}
+
+// amount as a share of maxAmount in buckets, rounded up so only nothing at all is in bucket 0. Shields aren't
+// capped by the max, so the bucket can go past buckets up to 255
+func bucket(amount, maxAmount float64, buckets int) uint64 {
+	if maxAmount <= 0 || amount <= 0 {
+		return 0
+	}
+	return uint64(min(math.Ceil(amount/maxAmount*float64(buckets)), 255))
+}
@@ -0,0 +1,123 @@
+package game
+
+import "testing"
+
+// The hash UpdateSquare would reach by adding every square of the board from scratch
+func rebuiltHash(board *Board) uint64 {
+	hash := uint64(0)
+	for i := range board.BoardArray {
+		hash ^= pieceKey(&board.BoardArray[i], uint16(i))
+	}
+	return hash
+}
+
+// Two pieces a side with abilities that miss, leave cooldowns, poison and a shield behind, before combat
+func newHashTestBoard() Board {
+	poison := StatusEffect{Name: "Poison", Kind: PoisonStatus, Duration: 2, Amount: 1, Stacking: StackStacking, TickPhase: TurnStartPhase}
+	shield := StatusEffect{Name: "Shield", Kind: ShieldStatus, Duration: 1, Amount: 2, TickPhase: TurnEndPhase}
+	abilities := []Ability{
+		{Name: "Strike", Range: 1, TargetEnemy: true, Components: []interface{}{FlatHitChance{Chance: 0.7}, FlatDamage{Amount: 3}}},
+		{Name: "Venom", Range: 3, Cooldown: 2, Cost: 2, TargetEnemy: true, Components: []interface{}{ApplyStatusEffect{Effect: poison}}},
+		{Name: "Guard", Range: 2, Cooldown: 1, TargetSelf: true, TargetFriendly: true, Components: []interface{}{ApplyStatusEffect{Effect: shield}}},
+	}
+
+	boardArray := make([]Piece, 36)
+	for i := range boardArray {
+		boardArray[i] = Piece{Name: "Empty", PieceType: EmptyPiece}
+	}
+	boardArray[1] = newTestPiece("Rogue", PlayerPiece, 12, abilities...)
+	boardArray[2] = newTestPiece("Knight", PlayerPiece, 15, abilities...)
+	boardArray[14] = Piece{Name: "Tree", PieceType: TerrainPiece, BlocksLOS: true, BlocksMove: true}
+	boardArray[33] = newTestPiece("Goblin", EnemyPiece, 12, abilities...)
+	boardArray[34] = newTestPiece("Orc", EnemyPiece, 15, abilities...)
+
+	board := Board{}
+	board.InitBoard(6, 6, boardArray)
+	return board
+}
+
+// Plays random actions, checking the hashes after every one of them
+func TestHash(t *testing.T) {
+	setup := State{Board: newHashTestBoard(), CurrentActor: PlayerActor}
+	for seed := range int64(20) {
+		state := setup.Clone()
+		state.StartCombat(seed)
+		picker := NewRNG(seed + 100)
+		var actions []Action
+
+		for len(actions) < 150 && state.WaitingForInput() {
+			before := state.Hash()
+			possible := state.GetPossibleActions()
+			action := possible[picker.Intn(len(possible))]
+			state.ExecuteAction(action)
+			actions = append(actions, action)
+
+			if got, want := state.Board.Hash(), rebuiltHash(&state.Board); got != want {
+				t.Fatalf("seed %d action %d: incremental hash %x, rebuilt %x", seed, len(actions), got, want)
+			}
+			// Every action spends budget or ends the turn, even a miss, so no position follows itself
+			if state.Hash() == before {
+				t.Fatalf("seed %d action %d: %+v left the hash unchanged", seed, len(actions), action)
+			}
+
+			clone := state.Clone()
+			if clone.Hash() != state.Hash() || rebuiltHash(&clone.Board) != clone.Board.Hash() {
+				t.Fatalf("seed %d action %d: the clone hashes differently", seed, len(actions))
+			}
+		}
+
+		replayed := Replay(setup, seed, actions)
+		if replayed.Hash() != state.Hash() {
+			t.Errorf("seed %d: replaying %d actions hashes %x, played %x", seed, len(actions), replayed.Hash(), state.Hash())
+		}
+	}
+}
+
+func TestHashTracksPieceState(t *testing.T) {
+	state := State{Board: newHashTestBoard(), CurrentActor: PlayerActor}
+	state.StartCombat(1)
+
+	changes := []struct {
+		name   string
+		change func(piece *Piece)
+	}{
+		{"spending a move", func(piece *Piece) { piece.MovesLeft-- }},
+		{"spending an action", func(piece *Piece) { piece.ActionsLeft-- }},
+		{"spending resource", func(piece *Piece) { piece.Stats.Resource.Spend(4) }},
+		{"a shield", func(piece *Piece) { piece.Stats.Health.AddShield(2) }},
+		{"the shield wearing off", func(piece *Piece) { piece.Stats.Health.AddShield(-2) }},
+		{"a cooldown", func(piece *Piece) { piece.Cooldowns = []uint8{0, 2, 0} }},
+		{"a shorter cooldown", func(piece *Piece) { piece.Cooldowns = []uint8{0, 1, 0} }},
+		{"a stun", func(piece *Piece) { piece.AddStatus(StatusEffect{Name: "Stun", Kind: StunStatus, Duration: 1}) }},
+		{"a stun running out", func(piece *Piece) { piece.StatusEffects[0].Duration = 0 }},
+	}
+	for _, change := range changes {
+		before := state.Hash()
+		change.change(&state.Board.BoardArray[1])
+		state.Board.RefreshSquare(1)
+		if state.Hash() == before {
+			t.Errorf("%s left the hash unchanged", change.name)
+		}
+	}
+
+	// Cooldowns that ran out and statuses in a different order are the same position
+	piece := &state.Board.BoardArray[1]
+	piece.Cooldowns = nil
+	piece.StatusEffects = nil
+	piece.AddStatus(StatusEffect{Name: "Stun", Kind: StunStatus, Duration: 1})
+	piece.AddStatus(StatusEffect{Name: "Root", Kind: RootStatus, Duration: 2})
+	key := pieceKey(piece, 1)
+
+	piece.Cooldowns = []uint8{0, 0, 0}
+	piece.StatusEffects[0], piece.StatusEffects[1] = piece.StatusEffects[1], piece.StatusEffects[0]
+	if pieceKey(piece, 1) != key {
+		t.Error("ready cooldowns or the status order changed the key")
+	}
+
+	// The same position a turn later is a different one
+	later := state.Clone()
+	later.turn += 2
+	if later.Hash() == state.Hash() {
+		t.Error("the turn is not part of the hash")
+	}
+}
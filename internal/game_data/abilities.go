@@ -0,0 +1,226 @@
+package game_data
+
+import (
+	"errors"
+	"fmt"
+
+	game "github.com/steuercarlsen/chessDungeonCrawler/internal/game"
+)
+
+// Filled from data/abilities.json. Heroes and enemies refer to abilities by their key
+var Abilities = map[string]game.Ability{}
+
+type AbilitySpec struct {
+	Name           string          `json:"name"`
+	Range          uint8           `json:"range"`
+	MinRange       uint8           `json:"minRange,omitempty"`
+	IgnoresLOS     bool            `json:"ignoresLOS,omitempty"`
+	Cooldown       uint8           `json:"cooldown,omitempty"`
+	Cost           float64         `json:"cost,omitempty"`
+	TargetSelf     bool            `json:"targetSelf"`
+	TargetFriendly bool            `json:"targetFriendly"`
+	TargetEnemy    bool            `json:"targetEnemy"`
+	TargetGround   bool            `json:"targetGround,omitempty"`
+	Area           *AreaSpec       `json:"area,omitempty"`
+	Components     []ComponentSpec `json:"components"`
+}
+
+type AreaSpec struct {
+	Shape        string `json:"shape"`
+	Size         uint8  `json:"size,omitempty"`
+	FriendlyFire bool   `json:"friendlyFire,omitempty"`
+}
+
+var shapeTypes = map[string]game.ShapeType{
+	"single": game.SingleShape,
+	"radius": game.RadiusShape,
+	"line":   game.LineShape,
+	"cone":   game.ConeShape,
+	"cross":  game.CrossShape,
+	"ring":   game.RingShape,
+}
+
+// A single ability component. Which of the value fields is used depends on Type
+type ComponentSpec struct {
+	Type   string      `json:"type"`
+	Amount float64     `json:"amount,omitempty"`
+	Chance float32     `json:"chance,omitempty"`
+	Status *StatusSpec `json:"status,omitempty"`
+}
+
+type StatusSpec struct {
+	Name      string             `json:"name"`
+	Kind      string             `json:"kind"`
+	Duration  uint8              `json:"duration"`
+	MaxStacks uint8              `json:"maxStacks,omitempty"`
+	Stacking  string             `json:"stacking,omitempty"`
+	Tick      string             `json:"tick,omitempty"`
+	Amount    float64            `json:"amount,omitempty"`
+	Modifier  *game.StatModifier `json:"modifier,omitempty"`
+}
+
+var statusKinds = map[string]game.StatusKind{
+	"poison":  game.PoisonStatus,
+	"burn":    game.BurnStatus,
+	"stun":    game.StunStatus,
+	"root":    game.RootStatus,
+	"silence": game.SilenceStatus,
+	"shield":  game.ShieldStatus,
+	"stat":    game.StatStatus,
+}
+
+var stackingRules = map[string]game.StackingRule{
+	"":        game.RefreshStacking,
+	"refresh": game.RefreshStacking,
+	"stack":   game.StackStacking,
+	"replace": game.ReplaceStacking,
+}
+
+var tickPhases = map[string]game.TurnPhase{
+	"start": game.TurnStartPhase,
+	"end":   game.TurnEndPhase,
+}
+
+func (s StatusSpec) toStatusEffect() (game.StatusEffect, error) {
+	var errs []error
+	kind, kindExists := statusKinds[s.Kind]
+	if !kindExists {
+		errs = append(errs, fmt.Errorf("unknown status kind %q", s.Kind))
+	}
+	stacking, exists := stackingRules[s.Stacking]
+	if !exists {
+		errs = append(errs, fmt.Errorf("unknown stacking rule %q", s.Stacking))
+	}
+	if s.Name == "" {
+		errs = append(errs, errors.New("status is missing a name"))
+	}
+	if s.Duration == 0 {
+		errs = append(errs, errors.New("status needs a duration"))
+	}
+
+	// Damage over time ticks at the start of the turn, everything else lasts until the end of it
+	tickPhase := game.TurnEndPhase
+	if kind == game.PoisonStatus || kind == game.BurnStatus {
+		tickPhase = game.TurnStartPhase
+	}
+	if s.Tick != "" {
+		phase, exists := tickPhases[s.Tick]
+		if !exists {
+			errs = append(errs, fmt.Errorf("unknown tick phase %q", s.Tick))
+		}
+		tickPhase = phase
+	}
+
+	effect := game.StatusEffect{
+		Name:      s.Name,
+		Kind:      kind,
+		Duration:  s.Duration,
+		MaxStacks: s.MaxStacks,
+		Stacking:  stacking,
+		TickPhase: tickPhase,
+		Amount:    s.Amount,
+	}
+
+	if kind == game.StatStatus && kindExists {
+		if s.Modifier == nil {
+			errs = append(errs, errors.New("stat status is missing a modifier"))
+		} else if err := (&game.StatStruct{}).ApplyModifier(*s.Modifier); err != nil {
+			errs = append(errs, err)
+		} else {
+			effect.Modifier = *s.Modifier
+		}
+	}
+
+	return effect, errors.Join(errs...)
+}
+
+func (c ComponentSpec) build() (interface{}, error) {
+	switch c.Type {
+	case "flatDamage":
+		return game.FlatDamage{Amount: c.Amount}, nil
+	case "flatHeal":
+		return game.FlatHeal{Amount: c.Amount}, nil
+	case "flatHitChance":
+		if c.Chance <= 0 || c.Chance > 1 {
+			return nil, fmt.Errorf("hit chance %v is not in (0, 1]", c.Chance)
+		}
+		return game.FlatHitChance{Chance: c.Chance}, nil
+	case "status":
+		if c.Status == nil {
+			return nil, errors.New("status component is missing its status")
+		}
+		effect, err := c.Status.toStatusEffect()
+		if err != nil {
+			return nil, err
+		}
+		return game.ApplyStatusEffect{Effect: effect}, nil
+	}
+	return nil, fmt.Errorf("unknown component type %q", c.Type)
+}
+
+func (a AbilitySpec) toAbility() (game.Ability, error) {
+	ability := game.Ability{
+		Name:           a.Name,
+		Range:          a.Range,
+		MinRange:       a.MinRange,
+		IgnoresLOS:     a.IgnoresLOS,
+		Cooldown:       a.Cooldown,
+		Cost:           a.Cost,
+		TargetSelf:     a.TargetSelf,
+		TargetFriendly: a.TargetFriendly,
+		TargetEnemy:    a.TargetEnemy,
+		TargetGround:   a.TargetGround,
+		Components:     make([]interface{}, 0, len(a.Components)),
+	}
+
+	var errs []error
+	if a.Name == "" {
+		errs = append(errs, errors.New("missing name"))
+	}
+	if a.Cost < 0 {
+		errs = append(errs, errors.New("cost can't be negative"))
+	}
+	if !a.TargetSelf && !a.TargetFriendly && !a.TargetEnemy && !a.TargetGround {
+		errs = append(errs, errors.New("ability can't target anything"))
+	}
+	if a.MinRange > a.Range {
+		errs = append(errs, fmt.Errorf("minimum range %d is above range %d", a.MinRange, a.Range))
+	}
+	if a.TargetSelf && a.MinRange > 0 {
+		errs = append(errs, errors.New("self targeting ability can't have a minimum range"))
+	}
+
+	if a.Area != nil {
+		shape, exists := shapeTypes[a.Area.Shape]
+		if !exists {
+			errs = append(errs, fmt.Errorf("unknown area shape %q", a.Area.Shape))
+		}
+		ability.Area = game.AreaShape{Type: shape, Size: a.Area.Size, FriendlyFire: a.Area.FriendlyFire}
+	}
+
+	for _, spec := range a.Components {
+		component, err := spec.build()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ability.Components = append(ability.Components, component)
+	}
+
+	return ability, errors.Join(errs...)
+}
+
+// Resolves ability IDs used by a hero or enemy
+func lookupAbilities(ids []string) ([]game.Ability, error) {
+	abilities := make([]game.Ability, 0, len(ids))
+	var errs []error
+	for _, id := range ids {
+		ability, exists := Abilities[id]
+		if !exists {
+			errs = append(errs, fmt.Errorf("unknown ability %q", id))
+			continue
+		}
+		abilities = append(abilities, ability)
+	}
+	return abilities, errors.Join(errs...)
+}
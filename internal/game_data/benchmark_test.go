@@ -0,0 +1,145 @@
+package game_data
+
+import (
+	"testing"
+
+	game "github.com/steuercarlsen/chessDungeonCrawler/internal/game"
+)
+
+// The skirmish on the default 8x8 board, in combat with the player to move
+func benchmarkState(b *testing.B) game.State {
+	b.Helper()
+	return startEncounter(b, skirmish, skirmishHeroes, 1)
+}
+
+func BenchmarkClone(b *testing.B) {
+	state := benchmarkState(b)
+	b.ResetTimer()
+	for range b.N {
+		_ = state.Clone()
+	}
+}
+
+func BenchmarkPossibleActions(b *testing.B) {
+	state := benchmarkState(b)
+	b.ResetTimer()
+	for range b.N {
+		_ = state.GetPossibleActions()
+	}
+}
+
+// Every pair of squares once per iteration
+func BenchmarkLOS(b *testing.B) {
+	state := benchmarkState(b)
+	size := uint16(state.Board.Size())
+	b.ResetTimer()
+	for range b.N {
+		for index := range size {
+			for target := range size {
+				_ = state.Board.CalculateLos(index, target)
+			}
+		}
+	}
+}
+
+func BenchmarkSearch(b *testing.B) {
+	state := benchmarkState(b)
+	b.ResetTimer()
+	for range b.N {
+		search := game.NewMCTS(state, 0, 300, 40)
+		search.SetSeed(7)
+		search.Search()
+	}
+}
+
+// Four enemies against a knight and a mage, even enough that how well the AI plays decides most games
+var ambush = Encounter{
+	Name:   "Ambush",
+	Width:  8,
+	Height: 8,
+	Board: map[int]SquareSpec{
+		10: {Type: EnemySquare, ID: "Enemy1"},
+		11: {Type: EnemySquare, ID: "Enemy1"},
+		12: {Type: EnemySquare, ID: "Enemy1"},
+		13: {Type: EnemySquare, ID: "Enemy1"},
+		27: {Type: TerrainSquare, ID: "Tree"},
+		36: {Type: TerrainSquare, ID: "Tree"},
+		50: {Type: PlayerAreaSquare},
+		51: {Type: PlayerAreaSquare},
+	},
+	Ground:    map[int]string{19: "Mud", 20: "Mud"},
+	AIProfile: DefaultAIProfile,
+}
+
+var ambushHeroes = map[int]string{50: "Knight", 51: "Mage"}
+
+// Plays the state to the end with the AI searching timeLimit milliseconds for every action with policy, and
+// the player attacking when it can and approaching the nearest enemy otherwise. Returns 1 if the AI won, 0 if
+// it lost and 0.5 if the game was cut off after maxActions
+func playAgainstScript(state game.State, policy game.RolloutPolicy, timeLimit uint16, seed int64) float64 {
+	const maxActions = 400
+
+	search := game.NewMCTS(state, timeLimit, 0, 40)
+	search.SetSeed(seed)
+	search.SetRolloutPolicy(policy)
+	script := game.EpsilonGreedyPolicy{}
+	scriptRNG := game.NewRNG(seed)
+
+	for range maxActions {
+		if !state.WaitingForInput() {
+			break
+		}
+		action := game.EndTurnAction
+		if state.CurrentActor == game.AIActor {
+			if best, _ := search.Search(); best != nil {
+				action = *best
+			}
+		} else {
+			action = script.ChooseAction(&state, state.GetPossibleActions(), &scriptRNG)
+		}
+		state.ExecuteAction(action)
+		search.Advance(state, []game.Action{action})
+	}
+
+	switch aiWin, playerWin := state.IsTerminal(); {
+	case aiWin:
+		return 1
+	case playerWin:
+		return 0
+	}
+	return 0.5
+}
+
+// Gives every rollout policy the same time per search and lets the AI play whole games with it against the
+// same scripted player. Cut off games count as half a win
+func BenchmarkRolloutPolicies(b *testing.B) {
+	const (
+		timeLimit = 20 // Milliseconds per AI action
+		games     = 6  // Per iteration, each from its own combat seed
+	)
+
+	policies := []struct {
+		name   string
+		policy game.RolloutPolicy
+	}{
+		{"Uniform", game.UniformPolicy{}},
+		{"EpsilonGreedy", game.EpsilonGreedyPolicy{Epsilon: 0.2}},
+		{"Softmax", game.SoftmaxPolicy{Temperature: 0.5}},
+	}
+
+	setup := deployHeroes(b, ambush, ambushHeroes)
+	for _, policy := range policies {
+		b.Run(policy.name, func(b *testing.B) {
+			var wins float64
+			for i := range b.N {
+				for game := range games {
+					seed := int64(i*games + game)
+					state := setup.Clone()
+					state.StartCombat(seed)
+					wins += playAgainstScript(state, policy.policy, timeLimit, seed)
+				}
+			}
+			b.ReportMetric(wins/float64(b.N*games), "winrate")
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package game_data
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+
+	game "github.com/steuercarlsen/chessDungeonCrawler/internal/game"
+)
+
+// Bump when a data file format changes in a way old files can't be read
+const DataFileVersion = 1
+
+//go:embed data
+var dataFiles embed.FS
+
+func init() {
+	if err := LoadData(dataFiles, "data"); err != nil {
+		panic(err)
+	}
+}
+
+// Loads all registries from dir. Later files refer to earlier ones, so the order matters
+func LoadData(fsys fs.FS, dir string) error {
+	loaders := []struct {
+		name string
+		load func(fs.FS, string) error
+	}{
+		{"abilities.json", LoadAbilities},
+		{"items.json", LoadItems},
+		{"enemies.json", LoadEnemies},
+		{"heroes.json", LoadHeroes},
+		{"profiles.json", LoadAIProfiles},
+	}
+
+	for _, loader := range loaders {
+		if err := loader.load(fsys, path.Join(dir, loader.name)); err != nil {
+			return err
+		}
+	}
+
+	return LoadEncounters(fsys, path.Join(dir, "encounters"))
+}
+
+// Every registry file is a version and a map from ID to definition
+type dataFile[T any] struct {
+	Version int          `json:"version"`
+	Entries map[string]T `json:"entries"`
+}
+
+func readDataFile[T any](fsys fs.FS, name string) (map[string]T, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var file dataFile[T]
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	if file.Version != DataFileVersion {
+		return nil, fmt.Errorf("%s: unsupported version %d", name, file.Version)
+	}
+	return file.Entries, nil
+}
+
+func LoadAbilities(fsys fs.FS, name string) error {
+	specs, err := readDataFile[AbilitySpec](fsys, name)
+	if err != nil {
+		return err
+	}
+
+	loaded := make(map[string]game.Ability, len(specs))
+	var errs []error
+	for id, spec := range specs {
+		ability, err := spec.toAbility()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: ability %s: %w", name, id, err))
+			continue
+		}
+		loaded[id] = ability
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	for id, ability := range loaded {
+		Abilities[id] = ability
+	}
+	return nil
+}
+
+func LoadItems(fsys fs.FS, name string) error {
+	items, err := readDataFile[Item](fsys, name)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for id, item := range items {
+		if err := item.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: item %s: %w", name, id, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	for id, item := range items {
+		Items[id] = item
+	}
+	return nil
+}
+
+func LoadEnemies(fsys fs.FS, name string) error {
+	specs, err := readDataFile[EnemySpec](fsys, name)
+	if err != nil {
+		return err
+	}
+
+	loaded := make(map[string]*game.Enemy, len(specs))
+	var errs []error
+	for id, spec := range specs {
+		enemy, err := spec.toEnemy()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: enemy %s: %w", name, id, err))
+			continue
+		}
+		loaded[id] = enemy
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	for id, enemy := range loaded {
+		game.Enemies[id] = enemy
+	}
+	return nil
+}
+
+func LoadHeroes(fsys fs.FS, name string) error {
+	specs, err := readDataFile[HeroSpec](fsys, name)
+	if err != nil {
+		return err
+	}
+
+	loaded := make(map[string]Hero, len(specs))
+	var errs []error
+	for id, spec := range specs {
+		hero, err := spec.toHero()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: hero %s: %w", name, id, err))
+			continue
+		}
+		loaded[id] = hero
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	for id, hero := range loaded {
+		Heroes[id] = hero
+	}
+	return nil
+}
+
+func LoadAIProfiles(fsys fs.FS, name string) error {
+	profiles, err := readDataFile[AIProfile](fsys, name)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for id, profile := range profiles {
+		if err := profile.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: profile %s: %w", name, id, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	for id, profile := range profiles {
+		AIProfiles[id] = profile
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package game_data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+
+	"github.com/steuercarlsen/chessDungeonCrawler/internal/board_map"
+)
+
+// On-disk format of an encounter. Squares are board indexes from 0 (top left) to width*height-1 (bottom right).
+// Width and height default to an 8x8 board and the AI profile to DefaultAIProfile
+type EncounterFile struct {
+	Version       int            `json:"version"`
+	ID            string         `json:"id"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	Width         int            `json:"width,omitempty"`
+	Height        int            `json:"height,omitempty"`
+	Enemies       []PlacedID     `json:"enemies"`
+	Terrain       []PlacedID     `json:"terrain"`
+	Ground        []PlacedID     `json:"ground,omitempty"`
+	DeployZone    []int          `json:"deployZone"`
+	WinConditions []WinCondition `json:"winConditions"`
+	AIProfile     string         `json:"aiProfile,omitempty"`
+}
+
+type PlacedID struct {
+	ID     string `json:"id"`
+	Square int    `json:"square"`
+}
+
+// Validates the file and converts it into an Encounter. Overlaps are only detectable here, because the
+// Encounter board is keyed by square
+func (f *EncounterFile) ToEncounter() (Encounter, error) {
+	var errs []error
+
+	if f.Version != DataFileVersion {
+		errs = append(errs, fmt.Errorf("unsupported version %d", f.Version))
+	}
+	if f.ID == "" {
+		errs = append(errs, errors.New("missing id"))
+	}
+
+	board := make(map[int]SquareSpec, len(f.Enemies)+len(f.Terrain)+len(f.DeployZone))
+	place := func(square int, spec SquareSpec) {
+		if _, taken := board[square]; taken {
+			errs = append(errs, fmt.Errorf("square %d is used more than once", square))
+			return
+		}
+		board[square] = spec
+	}
+
+	for _, enemy := range f.Enemies {
+		place(enemy.Square, SquareSpec{Type: EnemySquare, ID: enemy.ID})
+	}
+	for _, terrain := range f.Terrain {
+		place(terrain.Square, SquareSpec{Type: TerrainSquare, ID: terrain.ID})
+	}
+	for _, square := range f.DeployZone {
+		place(square, SquareSpec{Type: PlayerAreaSquare})
+	}
+
+	// Ground lies under the other squares, so it may share a square with them but not with other ground
+	ground := make(map[int]string, len(f.Ground))
+	for _, placed := range f.Ground {
+		if _, taken := ground[placed.Square]; taken {
+			errs = append(errs, fmt.Errorf("square %d has more than one ground", placed.Square))
+			continue
+		}
+		ground[placed.Square] = placed.ID
+	}
+
+	winConditions := f.WinConditions
+	if len(winConditions) == 0 {
+		winConditions = []WinCondition{{Type: DefeatAllCondition}}
+	}
+
+	width, height := f.Width, f.Height
+	if width == 0 {
+		width = board_map.DefaultWidth
+	}
+	if height == 0 {
+		height = board_map.DefaultHeight
+	}
+
+	aiProfile := f.AIProfile
+	if aiProfile == "" {
+		aiProfile = DefaultAIProfile
+	}
+
+	encounter := Encounter{
+		Name:          f.Name,
+		Description:   f.Description,
+		Width:         width,
+		Height:        height,
+		Board:         board,
+		Ground:        ground,
+		AIProfile:     aiProfile,
+		WinConditions: winConditions,
+	}
+
+	if err := encounter.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return encounter, fmt.Errorf("encounter file %s: %w", f.ID, err)
+	}
+	return encounter, nil
+}
+
+func ParseEncounterFile(data []byte) (string, Encounter, error) {
+	var file EncounterFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return "", Encounter{}, err
+	}
+	encounter, err := file.ToEncounter()
+	return file.ID, encounter, err
+}
+
+// Loads every .json file in dir into Encounters. Nothing is added if any file is invalid
+func LoadEncounters(fsys fs.FS, dir string) error {
+	matches, err := fs.Glob(fsys, path.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	loaded := make(map[string]Encounter, len(matches))
+	var errs []error
+
+	for _, name := range matches {
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		id, encounter, err := ParseEncounterFile(data)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		if _, exists := loaded[id]; exists {
+			errs = append(errs, fmt.Errorf("%s: duplicate encounter id %q", name, id))
+			continue
+		}
+		loaded[id] = encounter
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	for id, encounter := range loaded {
+		Encounters[id] = encounter
+	}
+	return nil
+}
@@ -1,43 +1,226 @@
 package game_data
 
-import game "github.com/steuercarlsen/chessDungeonCrawler/internal/game"
+import (
+	"errors"
+	"fmt"
+	"slices"
 
-var Encounters = map[string]Encounter{
-	"TestEncounter": {
-		Name:        "TestEncounter",
-		Description: "This is a test encounter",
-		Board: map[int]struct{}{
-			0: TestEnemy,
-			1: Tree,
-			2: PlayerArea{},
-		},
-	},
+	"github.com/steuercarlsen/chessDungeonCrawler/internal/board_map"
+	game "github.com/steuercarlsen/chessDungeonCrawler/internal/game"
+)
+
+// Filled from the encounter files in data/encounters, see encounter_file.go
+var Encounters = map[string]Encounter{}
+
+type SquareType uint8
+
+const (
+	EnemySquare SquareType = iota
+	TerrainSquare
+	PlayerAreaSquare
+)
+
+// Describes what starts on a square. ID refers to Enemies or Terrains and is unused for the player area
+type SquareSpec struct {
+	Type SquareType
+	ID   string
+}
+
+type WinConditionType string
+
+const (
+	DefeatAllCondition WinConditionType = "defeatAll"
+	SurviveCondition   WinConditionType = "survive"
+)
+
+// Defeating all enemies always wins, survive additionally wins after Turns rounds
+type WinCondition struct {
+	Type  WinConditionType `json:"type"`
+	Turns uint16           `json:"turns,omitempty"`
 }
 
+// Board and Ground are keyed by square index on a Width x Height board, see board_map. Ground refers to Grounds
 type Encounter struct {
-	Name        string
-	Description string
-	Board       map[int]struct{}
+	Name          string
+	Description   string
+	Width         int
+	Height        int
+	Board         map[int]SquareSpec
+	Ground        map[int]string
+	AIProfile     string
+	WinConditions []WinCondition
 }
 
-func (e *Encounter) ExportEncounter() [64]game.Piece {
-	exportArray := [64]game.Piece{}
+// Checks the board size, that every square is on the board, every ID exists and that the player has
+// somewhere to deploy
+func (e *Encounter) Validate() error {
+	var errs []error
+	hasPlayerArea := false
 
-	// Loop through the board data and create Pieces for each square
-	for i := range exportArray {
-		_, exists := e.Board[i]
-		if !exists {
-			exportArray[i] = game.Piece{Name: "Empty", PieceType: game.EmptyPiece}
-			continue
+	if e.Width < 1 || e.Width > board_map.MaxWidth || e.Height < 1 || e.Height > board_map.MaxHeight {
+		errs = append(errs, fmt.Errorf("board size %dx%d is outside 1x1 to %dx%d",
+			e.Width, e.Height, board_map.MaxWidth, board_map.MaxHeight))
+	}
+
+	for i, square := range e.Board {
+		if i < 0 || i >= e.Width*e.Height {
+			errs = append(errs, fmt.Errorf("square %d is outside the board", i))
+		}
+
+		switch square.Type {
+		case EnemySquare:
+			if _, exists := game.Enemies[square.ID]; !exists {
+				errs = append(errs, fmt.Errorf("unknown enemy %q on square %d", square.ID, i))
+			}
+		case TerrainSquare:
+			if _, exists := Terrains[square.ID]; !exists {
+				errs = append(errs, fmt.Errorf("unknown terrain %q on square %d", square.ID, i))
+			}
+		case PlayerAreaSquare:
+			hasPlayerArea = true
+		default:
+			errs = append(errs, fmt.Errorf("unknown square type %d on square %d", square.Type, i))
 		}
-		// $TODO: Implement the rest of the piece types
 	}
 
-	return exportArray
+	for i, id := range e.Ground {
+		if i < 0 || i >= e.Width*e.Height {
+			errs = append(errs, fmt.Errorf("ground on square %d is outside the board", i))
+		}
+		if _, exists := Grounds[id]; !exists {
+			errs = append(errs, fmt.Errorf("unknown ground %q on square %d", id, i))
+		}
+	}
+
+	if _, exists := AIProfiles[e.AIProfile]; !exists {
+		errs = append(errs, fmt.Errorf("unknown AI profile %q", e.AIProfile))
+	}
+
+	if !hasPlayerArea {
+		errs = append(errs, errors.New("no player area"))
+	}
+
+	for _, condition := range e.WinConditions {
+		switch condition.Type {
+		case DefeatAllCondition:
+		case SurviveCondition:
+			if condition.Turns == 0 {
+				errs = append(errs, errors.New("survive win condition needs turns"))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("unknown win condition %q", condition.Type))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("encounter %s: %w", e.Name, err)
+	}
+	return nil
 }
 
-type PlayerArea struct{}
+// Creates a combat state in setup with the encounter's board and win conditions
+func (e *Encounter) NewCombatState() (game.State, error) {
+	state := game.State{GameState: game.SetupCombat}
 
-type Enemy struct{}
+	boardArray, err := e.ExportEncounter()
+	if err != nil {
+		return state, err
+	}
+	state.Board.InitBoard(e.Width, e.Height, boardArray)
 
-var TestEnemy = Enemy{}
+	for i, id := range e.Ground {
+		ground, exists := Grounds[id]
+		if !exists || i < 0 || i >= e.Width*e.Height {
+			return state, fmt.Errorf("encounter %s: invalid ground %q on square %d", e.Name, id, i)
+		}
+		state.Board.SetMoveCost(uint16(i), ground.MoveCost)
+	}
+
+	for _, condition := range e.WinConditions {
+		if condition.Type == SurviveCondition {
+			state.SurviveTurns = condition.Turns
+		}
+	}
+
+	return state, nil
+}
+
+// Places the heroes on the encounter's player area squares in square order, before combat starts. Player
+// area squares left over are kept as they are
+func (e *Encounter) DeployHeroes(state *game.State, heroes []Hero) error {
+	if state.GameState != game.SetupCombat {
+		return fmt.Errorf("encounter %s: heroes can only be deployed before combat", e.Name)
+	}
+	if len(heroes) == 0 {
+		return fmt.Errorf("encounter %s: no heroes to deploy", e.Name)
+	}
+
+	squares := make([]int, 0, len(e.Board))
+	for i, square := range e.Board {
+		if square.Type == PlayerAreaSquare {
+			squares = append(squares, i)
+		}
+	}
+	if len(heroes) > len(squares) {
+		return fmt.Errorf("encounter %s: %d heroes for %d player area squares", e.Name, len(heroes), len(squares))
+	}
+	slices.Sort(squares)
+
+	for i, hero := range heroes {
+		state.Board.PlacePiece(uint16(squares[i]), hero.NewPiece())
+	}
+	return nil
+}
+
+// Creates the AI's search for a state of the encounter, scoring cut off rollouts with the encounter's AI profile.
+// See game.NewMCTS for the budget
+func (e *Encounter) NewSearch(state game.State, timeLimit, iterationGoal, maxDepth uint16) (*game.MCTS, error) {
+	profile, exists := AIProfiles[e.AIProfile]
+	if !exists {
+		return nil, fmt.Errorf("encounter %s: unknown AI profile %q", e.Name, e.AIProfile)
+	}
+
+	search := game.NewMCTS(state, timeLimit, iterationGoal, maxDepth)
+	search.SetEvaluator(profile.Evaluator())
+	return search, nil
+}
+
+// Creates the pieces of every square, row by row
+func (e *Encounter) ExportEncounter() ([]game.Piece, error) {
+	if e.Width < 1 || e.Width > board_map.MaxWidth || e.Height < 1 || e.Height > board_map.MaxHeight {
+		return nil, fmt.Errorf("encounter %s: board size %dx%d is not supported", e.Name, e.Width, e.Height)
+	}
+	exportArray := make([]game.Piece, e.Width*e.Height)
+
+	for i := range exportArray {
+		exportArray[i] = game.Piece{Name: "Empty", PieceType: game.EmptyPiece}
+	}
+
+	// Loop through the board data and create Pieces for each square
+	for i, square := range e.Board {
+		if i < 0 || i >= len(exportArray) {
+			return exportArray, fmt.Errorf("encounter %s: square %d is outside the board", e.Name, i)
+		}
+
+		switch square.Type {
+		case EnemySquare:
+			enemy, exists := game.Enemies[square.ID]
+			if !exists {
+				return exportArray, fmt.Errorf("encounter %s: unknown enemy %q on square %d", e.Name, square.ID, i)
+			}
+			exportArray[i] = enemy.NewPiece()
+		case TerrainSquare:
+			terrain, exists := Terrains[square.ID]
+			if !exists {
+				return exportArray, fmt.Errorf("encounter %s: unknown terrain %q on square %d", e.Name, square.ID, i)
+			}
+			exportArray[i] = terrain.NewPiece()
+		case PlayerAreaSquare:
+			exportArray[i] = game.Piece{Name: "PlayerArea", PieceType: game.PlayerAreaPiece}
+		default:
+			return exportArray, fmt.Errorf("encounter %s: unknown square type %d on square %d", e.Name, square.Type, i)
+		}
+	}
+
+	return exportArray, nil
+}
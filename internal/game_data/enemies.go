@@ -0,0 +1,37 @@
+package game_data
+
+import (
+	"errors"
+
+	game "github.com/steuercarlsen/chessDungeonCrawler/internal/game"
+)
+
+// Enemies are stored in game.Enemies, this is their data file format
+type EnemySpec struct {
+	Name      string    `json:"name"`
+	Stats     BaseStats `json:"stats"`
+	MoveRange uint8     `json:"moveRange"`
+	Abilities []string  `json:"abilities"`
+}
+
+func (e EnemySpec) toEnemy() (*game.Enemy, error) {
+	var errs []error
+	if e.Name == "" {
+		errs = append(errs, errors.New("missing name"))
+	}
+	if err := e.Stats.validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	abilities, err := lookupAbilities(e.Abilities)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return &game.Enemy{
+		Name:      e.Name,
+		Stats:     e.Stats.ToStatStruct(),
+		MoveRange: e.MoveRange,
+		Abilities: abilities,
+	}, errors.Join(errs...)
+}
@@ -1,8 +1,110 @@
 package game_data
 
+import (
+	"errors"
+	"fmt"
+
+	game "github.com/steuercarlsen/chessDungeonCrawler/internal/game"
+)
+
+// Filled from data/heroes.json
 var Heroes = map[string]Hero{}
 
+// Stats always holds BaseStats plus the modifiers of the equipment, in equipment order
 type Hero struct {
 	Name      string
+	BaseStats BaseStats
+	Stats     game.StatStruct
+	MoveRange uint8
+	Abilities []game.Ability
 	Equipment []Item
 }
+
+// Equips the item, replacing and returning whatever was in the same slot
+func (h *Hero) Equip(item Item) (Item, bool) {
+	previous, replaced := h.Unequip(item.Slot)
+	// Never append in place, heroes are copied out of the registry by value
+	h.Equipment = append(h.Equipment[:len(h.Equipment):len(h.Equipment)], item)
+	h.recalculateStats()
+	return previous, replaced
+}
+
+// Removes the item in the slot, the hero's stats are exactly as if it was never equipped
+func (h *Hero) Unequip(slot ItemSlot) (Item, bool) {
+	for i, item := range h.Equipment {
+		if item.Slot != slot {
+			continue
+		}
+		equipment := make([]Item, 0, len(h.Equipment)-1)
+		equipment = append(equipment, h.Equipment[:i]...)
+		h.Equipment = append(equipment, h.Equipment[i+1:]...)
+		h.recalculateStats()
+		return item, true
+	}
+	return Item{}, false
+}
+
+// Rebuilds the stats from scratch rather than subtracting bonuses, so unequipping leaves no float residue
+func (h *Hero) recalculateStats() {
+	h.Stats = h.BaseStats.ToStatStruct()
+	for _, item := range h.Equipment {
+		for _, modifier := range item.Modifiers {
+			// Items are validated on load, so the modifiers are known to apply
+			_ = h.Stats.ApplyModifier(modifier)
+		}
+	}
+}
+
+// Creates the combat piece for the hero with its final stats
+func (h *Hero) NewPiece() game.Piece {
+	return game.Piece{
+		Name:       h.Name,
+		Abilities:  h.Abilities,
+		PieceType:  game.PlayerPiece,
+		BlocksMove: true,
+		MoveRange:  h.MoveRange,
+		Stats:      h.Stats,
+	}
+}
+
+type HeroSpec struct {
+	Name      string    `json:"name"`
+	Stats     BaseStats `json:"stats"`
+	MoveRange uint8     `json:"moveRange"`
+	Abilities []string  `json:"abilities"`
+	Equipment []string  `json:"equipment"`
+}
+
+func (h HeroSpec) toHero() (Hero, error) {
+	var errs []error
+	if h.Name == "" {
+		errs = append(errs, errors.New("missing name"))
+	}
+	if err := h.Stats.validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	abilities, err := lookupAbilities(h.Abilities)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	equipment, err := lookupItems(h.Equipment)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	hero := Hero{
+		Name:      h.Name,
+		BaseStats: h.Stats,
+		MoveRange: h.MoveRange,
+		Abilities: abilities,
+	}
+	for _, item := range equipment {
+		if _, replaced := hero.Equip(item); replaced {
+			errs = append(errs, fmt.Errorf("more than one %s item", item.Slot))
+		}
+	}
+	hero.recalculateStats()
+
+	return hero, errors.Join(errs...)
+}
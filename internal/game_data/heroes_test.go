@@ -0,0 +1,123 @@
+package game_data
+
+import (
+	"reflect"
+	"testing"
+
+	game "github.com/steuercarlsen/chessDungeonCrawler/internal/game"
+)
+
+var (
+	flatHealth    = Item{Name: "Flat Health", Slot: ArmorSlot, Modifiers: []game.StatModifier{{Stat: "health", Type: game.FlatModifier, Amount: 5}}}
+	percentHealth = Item{Name: "Percent Health", Slot: TrinketSlot, Modifiers: []game.StatModifier{{Stat: "health", Type: game.PercentModifier, Amount: 0.1}}}
+	mixedAttack   = Item{Name: "Mixed Attack", Slot: WeaponSlot, Modifiers: []game.StatModifier{
+		{Stat: "attack", Type: game.PercentModifier, Amount: 0.5},
+		{Stat: "attack", Type: game.FlatModifier, Amount: 2},
+		{Stat: "attack", Type: game.PercentModifier, Amount: 0.25},
+	}}
+)
+
+func newTestHero() Hero {
+	base := BaseStats{Health: 20, Resource: 10, Attack: 6, Defense: 4, Accuracy: 0.9}
+	return Hero{Name: "Tester", BaseStats: base, Stats: base.ToStatStruct()}
+}
+
+func TestEquipStacking(t *testing.T) {
+	// Total = Base * (1 + sum of percent bonuses) + sum of flat bonuses, whatever the equipment order
+	wantHealth := 20*(1+0.1) + 5
+	wantAttack := 6*(1+0.5+0.25) + 2
+
+	orders := [][]Item{
+		{flatHealth, percentHealth, mixedAttack},
+		{percentHealth, flatHealth, mixedAttack},
+		{mixedAttack, percentHealth, flatHealth},
+	}
+	for _, order := range orders {
+		hero := newTestHero()
+		for _, item := range order {
+			hero.Equip(item)
+		}
+
+		if hero.Stats.Health.Max.Total != wantHealth || hero.Stats.Health.Current != wantHealth {
+			t.Errorf("equipping %v: health %v/%v, want %v", itemNames(order),
+				hero.Stats.Health.Current, hero.Stats.Health.Max.Total, wantHealth)
+		}
+		if hero.Stats.Attack.Total != wantAttack {
+			t.Errorf("equipping %v: attack %v, want %v", itemNames(order), hero.Stats.Attack.Total, wantAttack)
+		}
+		if hero.Stats.Defense.Total != 4 {
+			t.Errorf("equipping %v: defense %v, want 4", itemNames(order), hero.Stats.Defense.Total)
+		}
+	}
+}
+
+func TestEquipReplacesSlot(t *testing.T) {
+	hero := newTestHero()
+	if _, replaced := hero.Equip(flatHealth); replaced {
+		t.Error("equipping into an empty slot replaced an item")
+	}
+
+	other := Item{Name: "Other Armor", Slot: ArmorSlot, Modifiers: []game.StatModifier{{Stat: "defense", Type: game.FlatModifier, Amount: 3}}}
+	previous, replaced := hero.Equip(other)
+	if !replaced || previous.Name != flatHealth.Name {
+		t.Errorf("equipping over %s returned %q, %v", flatHealth.Name, previous.Name, replaced)
+	}
+	if len(hero.Equipment) != 1 || hero.Stats.Health.Max.Total != 20 || hero.Stats.Defense.Total != 7 {
+		t.Errorf("after replacing: %d items, health %v, defense %v", len(hero.Equipment),
+			hero.Stats.Health.Max.Total, hero.Stats.Defense.Total)
+	}
+}
+
+func TestUnequipRestoresStats(t *testing.T) {
+	hero := newTestHero()
+	bare := hero.Stats
+
+	// Bonuses that don't add up exactly in floating point
+	for range 50 {
+		hero.Equip(percentHealth)
+		hero.Equip(mixedAttack)
+		hero.Equip(flatHealth)
+		hero.Unequip(WeaponSlot)
+		hero.Unequip(TrinketSlot)
+		if _, removed := hero.Unequip(ArmorSlot); !removed {
+			t.Fatal("unequipping armor removed nothing")
+		}
+	}
+	if _, removed := hero.Unequip(ArmorSlot); removed {
+		t.Error("unequipping an empty slot removed an item")
+	}
+
+	if !reflect.DeepEqual(hero.Stats, bare) || len(hero.Equipment) != 0 {
+		t.Errorf("stats after unequipping everything:\n%+v\nwant\n%+v", hero.Stats, bare)
+	}
+
+	// Removing one item leaves exactly the stats of the others
+	hero.Equip(percentHealth)
+	withCharm := hero.Stats
+	hero.Equip(mixedAttack)
+	hero.Unequip(WeaponSlot)
+	if !reflect.DeepEqual(hero.Stats, withCharm) {
+		t.Errorf("stats after unequipping the weapon:\n%+v\nwant\n%+v", hero.Stats, withCharm)
+	}
+}
+
+func TestEquipLeavesRegistry(t *testing.T) {
+	hero := Heroes["Knight"]
+	equipment := append([]Item(nil), hero.Equipment...)
+	stats := hero.Stats
+
+	hero.Equip(flatHealth)
+	hero.Unequip(WeaponSlot)
+
+	if !reflect.DeepEqual(Heroes["Knight"].Equipment, equipment) || Heroes["Knight"].Stats != stats {
+		t.Error("equipping a copy changed the registered hero")
+	}
+}
+
+func itemNames(items []Item) []string {
+	names := make([]string, len(items))
+	for i, item := range items {
+		names[i] = item.Name
+	}
+	return names
+}
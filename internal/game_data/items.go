@@ -1,5 +1,60 @@
 package game_data
 
+import (
+	"errors"
+	"fmt"
+
+	game "github.com/steuercarlsen/chessDungeonCrawler/internal/game"
+)
+
+// Filled from data/items.json
 var Items = map[string]Item{}
 
-type Item struct{}
+type ItemSlot string
+
+const (
+	WeaponSlot  ItemSlot = "weapon"
+	ArmorSlot   ItemSlot = "armor"
+	TrinketSlot ItemSlot = "trinket"
+)
+
+type Item struct {
+	Name      string              `json:"name"`
+	Slot      ItemSlot            `json:"slot"`
+	Modifiers []game.StatModifier `json:"modifiers"`
+}
+
+func (i Item) validate() error {
+	var errs []error
+	if i.Name == "" {
+		errs = append(errs, errors.New("missing name"))
+	}
+
+	switch i.Slot {
+	case WeaponSlot, ArmorSlot, TrinketSlot:
+	default:
+		errs = append(errs, fmt.Errorf("unknown slot %q", i.Slot))
+	}
+
+	for _, modifier := range i.Modifiers {
+		if err := (&game.StatStruct{}).ApplyModifier(modifier); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Resolves item IDs used by a hero
+func lookupItems(ids []string) ([]Item, error) {
+	items := make([]Item, 0, len(ids))
+	var errs []error
+	for _, id := range ids {
+		item, exists := Items[id]
+		if !exists {
+			errs = append(errs, fmt.Errorf("unknown item %q", id))
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, errors.Join(errs...)
+}
@@ -0,0 +1,38 @@
+package game_data
+
+import (
+	"errors"
+
+	game "github.com/steuercarlsen/chessDungeonCrawler/internal/game"
+)
+
+// Filled from data/profiles.json. Encounters refer to a profile by its key
+var AIProfiles = map[string]AIProfile{}
+
+// Used by encounters that don't name a profile
+const DefaultAIProfile = "Balanced"
+
+// How the AI of an encounter judges positions it can't search to the end
+type AIProfile struct {
+	Name    string                 `json:"name"`
+	Weights game.EvaluationWeights `json:"weights"`
+}
+
+func (p AIProfile) validate() error {
+	var errs []error
+	if p.Name == "" {
+		errs = append(errs, errors.New("missing name"))
+	}
+	w := p.Weights
+	if w.Health < 0 || w.Pieces < 0 || w.Threat < 0 || w.Position < 0 {
+		errs = append(errs, errors.New("weights can't be negative"))
+	}
+	if w.Health+w.Pieces+w.Threat+w.Position == 0 {
+		errs = append(errs, errors.New("needs at least one weight"))
+	}
+	return errors.Join(errs...)
+}
+
+func (p AIProfile) Evaluator() game.Evaluator {
+	return game.HeuristicEvaluator{Weights: p.Weights}
+}
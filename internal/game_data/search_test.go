@@ -0,0 +1,180 @@
+package game_data
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	game "github.com/steuercarlsen/chessDungeonCrawler/internal/game"
+)
+
+// Three heroes against two enemies around a pair of trees, on the default board
+var skirmish = Encounter{
+	Name:   "Skirmish",
+	Width:  8,
+	Height: 8,
+	Board: map[int]SquareSpec{
+		10: {Type: EnemySquare, ID: "Enemy1"},
+		13: {Type: EnemySquare, ID: "Enemy1"},
+		27: {Type: TerrainSquare, ID: "Tree"},
+		36: {Type: TerrainSquare, ID: "Tree"},
+		50: {Type: PlayerAreaSquare},
+		51: {Type: PlayerAreaSquare},
+		52: {Type: PlayerAreaSquare},
+	},
+	Ground:    map[int]string{19: "Mud", 20: "Mud"},
+	AIProfile: DefaultAIProfile,
+}
+
+var skirmishHeroes = map[int]string{50: "Knight", 51: "Cleric", 52: "Mage"}
+
+// Fixed encounters for searches, with the heroes to deploy by square
+var searchFixtures = []struct {
+	name      string
+	encounter func() Encounter
+	heroes    map[int]string
+}{
+	{"TestEncounter", func() Encounter { return Encounters["TestEncounter"] }, map[int]string{2: "Knight"}},
+	{"Skirmish", func() Encounter { return skirmish }, skirmishHeroes},
+	{"SkirmishSurvive", func() Encounter {
+		encounter := skirmish
+		encounter.WinConditions = []WinCondition{{Type: SurviveCondition, Turns: 3}}
+		return encounter
+	}, skirmishHeroes},
+}
+
+// Sets up the encounter like the UI does, deploying the heroes on their squares, and starts combat with seed
+func startEncounter(tb testing.TB, encounter Encounter, heroes map[int]string, seed int64) game.State {
+	tb.Helper()
+	state := deployHeroes(tb, encounter, heroes)
+	state.StartCombat(seed)
+	return state
+}
+
+// The encounter's combat state in setup, with the heroes on their squares
+func deployHeroes(tb testing.TB, encounter Encounter, heroes map[int]string) game.State {
+	tb.Helper()
+	if err := encounter.Validate(); err != nil {
+		tb.Fatal(err)
+	}
+	state, err := encounter.NewCombatState()
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	for index, id := range heroes {
+		hero, exists := Heroes[id]
+		if !exists {
+			tb.Fatalf("unknown hero %q", id)
+		}
+		state.Board.PlacePiece(uint16(index), hero.NewPiece())
+	}
+	return state
+}
+
+// Compares one worker with several at the same total number of iterations
+func BenchmarkSearchWorkers(b *testing.B) {
+	const iterations = 480
+
+	for _, fixture := range searchFixtures {
+		state := startEncounter(b, fixture.encounter(), fixture.heroes, 1)
+		for _, workers := range []int{1, 2, 4} {
+			b.Run(fmt.Sprintf("%s/workers=%d", fixture.name, workers), func(b *testing.B) {
+				for range b.N {
+					search := game.NewMCTS(state, 0, uint16(iterations/workers), 40)
+					search.SetSeed(7)
+					search.SetWorkers(workers)
+					search.Search()
+				}
+			})
+		}
+	}
+}
+
+func TestSearchReproducible(t *testing.T) {
+	for _, fixture := range searchFixtures {
+		state := startEncounter(t, fixture.encounter(), fixture.heroes, 1)
+		for _, workers := range []int{1, 3} {
+			search := func() (*game.Action, game.SearchMetadata) {
+				mcts := game.NewMCTS(state, 0, 150, 30)
+				mcts.SetSeed(7)
+				mcts.SetWorkers(workers)
+				return mcts.Search()
+			}
+
+			action, metadata := search()
+			if action == nil {
+				t.Fatalf("%s with %d workers: no action", fixture.name, workers)
+			}
+			for range 3 {
+				again, againMetadata := search()
+				if !reflect.DeepEqual(again, action) || againMetadata != metadata {
+					t.Errorf("%s with %d workers: searched %+v %+v, then %+v %+v",
+						fixture.name, workers, *action, metadata, *again, againMetadata)
+				}
+			}
+		}
+	}
+}
+
+func TestReplayReproducible(t *testing.T) {
+	for _, fixture := range searchFixtures {
+		setup := deployHeroes(t, fixture.encounter(), fixture.heroes)
+		for seed := range int64(5) {
+			// Plays random actions, picked by a stream separate from the combat's own
+			played := setup.Clone()
+			played.StartCombat(seed)
+			picker := game.NewRNG(seed + 100)
+			var actions []game.Action
+			for len(actions) < 200 && played.WaitingForInput() {
+				possible := played.GetPossibleActions()
+				action := possible[picker.Intn(len(possible))]
+				played.ExecuteAction(action)
+				actions = append(actions, action)
+			}
+
+			replayed := game.Replay(setup, seed, actions)
+			if replayed.Hash() != played.Hash() || !reflect.DeepEqual(replayed, played) {
+				t.Errorf("%s seed %d: replaying %d actions gave a different state", fixture.name, seed, len(actions))
+			}
+		}
+	}
+}
+
+// Rollouts of 4 actions are mostly scored by the evaluator, so the profile's weights show in the results
+func TestNewSearchUsesAIProfile(t *testing.T) {
+	state := startEncounter(t, skirmish, skirmishHeroes, 1)
+	search := func(profile string) game.SearchMetadata {
+		encounter := skirmish
+		encounter.AIProfile = profile
+		mcts, err := encounter.NewSearch(state, 0, 100, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mcts.SetSeed(7)
+		_, metadata := mcts.Search()
+		return metadata
+	}
+
+	results := map[string]game.SearchMetadata{}
+	for id, profile := range AIProfiles {
+		mcts := game.NewMCTS(state, 0, 100, 4)
+		mcts.SetSeed(7)
+		mcts.SetEvaluator(profile.Evaluator())
+		_, want := mcts.Search()
+
+		results[id] = search(id)
+		if results[id] != want {
+			t.Errorf("profile %s: searched %+v, want %+v", id, results[id], want)
+		}
+	}
+	if results["Aggressive"] == results["Cautious"] {
+		t.Error("different profiles searched the same")
+	}
+
+	encounter := skirmish
+	encounter.AIProfile = "Unknown"
+	if _, err := encounter.NewSearch(state, 0, 100, 4); err == nil {
+		t.Error("searching with an unknown profile did not fail")
+	}
+}
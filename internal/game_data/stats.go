@@ -1 +1,53 @@
 package game_data
+
+import (
+	"errors"
+
+	game "github.com/steuercarlsen/chessDungeonCrawler/internal/game"
+)
+
+// Base values of a hero or enemy as written in data files, see game.StatStruct for their meaning
+type BaseStats struct {
+	Health     float64 `json:"health"`
+	Resource   float64 `json:"resource"`
+	Attack     float64 `json:"attack"`
+	Defense    float64 `json:"defense"`
+	MagicPower float64 `json:"magicPower"`
+	Resistance float64 `json:"resistance"`
+	Speed      float64 `json:"speed"`
+	Accuracy   float64 `json:"accuracy"`
+	Evasion    float64 `json:"evasion"`
+	CritChance float64 `json:"critChance"`
+}
+
+func (b BaseStats) ToStatStruct() game.StatStruct {
+	return game.StatStruct{
+		Health:     game.NewPool(game.HealthStat, b.Health),
+		Resource:   game.NewPool(game.ResourceStat, b.Resource),
+		Attack:     game.NewStat(game.FlatStat, b.Attack),
+		Defense:    game.NewStat(game.FlatStat, b.Defense),
+		MagicPower: game.NewStat(game.FlatStat, b.MagicPower),
+		Resistance: game.NewStat(game.FlatStat, b.Resistance),
+		Speed:      game.NewStat(game.FlatStat, b.Speed),
+		Accuracy:   game.NewStat(game.FlatStat, b.Accuracy),
+		Evasion:    game.NewStat(game.FlatStat, b.Evasion),
+		CritChance: game.NewStat(game.FlatStat, b.CritChance),
+	}
+}
+
+func (b BaseStats) validate() error {
+	var errs []error
+	if b.Health <= 0 {
+		errs = append(errs, errors.New("health must be positive"))
+	}
+	if b.Resource < 0 || b.Attack < 0 || b.Defense < 0 || b.MagicPower < 0 || b.Resistance < 0 || b.Speed < 0 {
+		errs = append(errs, errors.New("stats can't be negative"))
+	}
+	for _, fraction := range []float64{b.Accuracy, b.Evasion, b.CritChance} {
+		if fraction < 0 || fraction > 1 {
+			errs = append(errs, errors.New("accuracy, evasion and crit chance must be between 0 and 1"))
+			break
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -1,5 +1,49 @@
 package game_data
 
-type Terrain struct{}
+import game "github.com/steuercarlsen/chessDungeonCrawler/internal/game"
 
-var Tree = Terrain{}
+type Terrain struct {
+	Name       string
+	BlocksLOS  bool
+	BlocksMove bool
+}
+
+// Keep all terrain in a map for lookup when exporting an encounter
+var Terrains = map[string]Terrain{
+	"Tree": {
+		Name:       "Tree",
+		BlocksLOS:  true,
+		BlocksMove: true,
+	},
+}
+
+func (t *Terrain) NewPiece() game.Piece {
+	return game.Piece{
+		Name:       t.Name,
+		PieceType:  game.TerrainPiece,
+		BlocksLOS:  t.BlocksLOS,
+		BlocksMove: t.BlocksMove,
+	}
+}
+
+// Ground lies under whatever stands on a square and only changes what it costs to move onto it
+type Ground struct {
+	Name     string
+	MoveCost uint8
+}
+
+// Keep all ground in a map for lookup when creating the combat state of an encounter
+var Grounds = map[string]Ground{
+	"Mud": {
+		Name:     "Mud",
+		MoveCost: 2,
+	},
+	"ShallowWater": {
+		Name:     "ShallowWater",
+		MoveCost: 2,
+	},
+	"Rubble": {
+		Name:     "Rubble",
+		MoveCost: 3,
+	},
+}